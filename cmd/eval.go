@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/spf13/cobra"
+)
+
+// evalFlags holds the eval command options
+var evalFlags struct {
+	k               int
+	relevant        float64
+	jsonOutput      bool
+	weightsFile     string
+	baselineFile    string
+	recencyHalfLife float64
+}
+
+// evalCmd represents the eval command
+var evalCmd = &cobra.Command{
+	Use:   "eval <dataset.jsonl>",
+	Short: "Evaluate matching quality against a labeled dataset",
+	Long: `Evaluate matching quality offline against human-labeled CV/JD pairs.
+
+Each dataset line is either a JD with ranked candidates:
+  {"id": "backend", "jd_file": "jd.md", "candidates": [{"cv_file": "a.md", "score": 3}, ...]}
+or a single labeled pair (pairs sharing a JD are ranked together):
+  {"jd_file": "jd.md", "cv_file": "a.md", "score": 72}
+
+Documents can also be given inline with "jd" and "cv". File paths are relative
+to the dataset file. The report shows precision@k, NDCG@k and Spearman
+correlation against the labels, and how each metric changes when one score
+component is dropped. Save a report with --json and pass it as --baseline to
+compare a dictionary or scoring change against it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// #nosec G304 - The dataset path is supplied by the operator
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+
+		queries, err := analysis.ParseEvalDataset(f, filepath.Dir(args[0]))
+		if err != nil {
+			return fmt.Errorf("read dataset: %w", err)
+		}
+
+		engine := analysis.NewAnalysisEngine().WithRecencyHalfLife(evalFlags.recencyHalfLife)
+		if evalFlags.weightsFile != "" {
+			weights, err := readEvalWeights(evalFlags.weightsFile)
+			if err != nil {
+				return err
+			}
+			engine.WithWeights(weights)
+		}
+
+		var baseline *analysis.EvalReport
+		if evalFlags.baselineFile != "" {
+			if baseline, err = readEvalReport(evalFlags.baselineFile); err != nil {
+				return err
+			}
+		}
+
+		report, err := engine.Evaluate(context.Background(), queries, evalFlags.k, evalFlags.relevant)
+		if err != nil {
+			return err
+		}
+
+		if evalFlags.jsonOutput {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(report)
+		}
+		return printEvalReport(cmd.OutOrStdout(), report, baseline)
+	},
+}
+
+// readEvalWeights reads scoring weights from a JSON file: either bare weights or a trained
+// scoring profile with a "weights" field
+func readEvalWeights(path string) (analysis.ScoringWeights, error) {
+	// #nosec G304 - The weights path is supplied by the operator
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return analysis.ScoringWeights{}, err
+	}
+	var profile struct {
+		Weights *analysis.ScoringWeights `json:"weights"`
+	}
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return analysis.ScoringWeights{}, fmt.Errorf("decode weights %s: %w", path, err)
+	}
+	var weights analysis.ScoringWeights
+	if profile.Weights != nil {
+		weights = *profile.Weights
+	} else if err := json.Unmarshal(data, &weights); err != nil {
+		return weights, fmt.Errorf("decode weights %s: %w", path, err)
+	}
+	if err := weights.ValidateWeights(); err != nil {
+		return weights, fmt.Errorf("weights %s: %w", path, err)
+	}
+	return weights, nil
+}
+
+// readEvalReport reads a report saved with --json
+func readEvalReport(path string) (*analysis.EvalReport, error) {
+	// #nosec G304 - The baseline path is supplied by the operator
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var report analysis.EvalReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("decode baseline %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// printEvalReport writes the report as aligned tables, with changes against the baseline when given
+func printEvalReport(out io.Writer, report, baseline *analysis.EvalReport) error {
+	fmt.Fprintf(out, "Engine version: %s\n", report.EngineVersion)
+	fmt.Fprintf(out, "Dictionary: %s\n", report.DictionaryChecksum)
+	fmt.Fprintf(out, "Queries: %d, pairs: %d, k: %d, relevant: score >= %g\n\n",
+		report.Queries, report.Pairs, report.K, report.RelevantThreshold)
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if baseline != nil {
+		fmt.Fprintln(tw, "METRIC\tVALUE\tBASELINE\tCHANGE")
+		fmt.Fprintf(tw, "precision@%d\t%.3f\t%.3f\t%+.3f\n", report.K, report.Metrics.PrecisionAtK,
+			baseline.Metrics.PrecisionAtK, report.Metrics.PrecisionAtK-baseline.Metrics.PrecisionAtK)
+		fmt.Fprintf(tw, "ndcg@%d\t%.3f\t%.3f\t%+.3f\n", report.K, report.Metrics.NDCG,
+			baseline.Metrics.NDCG, report.Metrics.NDCG-baseline.Metrics.NDCG)
+		fmt.Fprintf(tw, "spearman\t%.3f\t%.3f\t%+.3f\n", report.Metrics.Spearman,
+			baseline.Metrics.Spearman, report.Metrics.Spearman-baseline.Metrics.Spearman)
+	} else {
+		fmt.Fprintln(tw, "METRIC\tVALUE")
+		fmt.Fprintf(tw, "precision@%d\t%.3f\n", report.K, report.Metrics.PrecisionAtK)
+		fmt.Fprintf(tw, "ndcg@%d\t%.3f\n", report.K, report.Metrics.NDCG)
+		fmt.Fprintf(tw, "spearman\t%.3f\n", report.Metrics.Spearman)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "\nAblations (change when the component is dropped; negative means it helps):")
+	tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "COMPONENT\tWEIGHT\tPRECISION\tNDCG\tSPEARMAN")
+	weights := [5]float64{report.Weights.SkillCoverage, report.Weights.Experience,
+		report.Weights.TermSimilarity, report.Weights.OverallMatch, report.Weights.Proficiency}
+	for i, ablation := range report.Ablations {
+		fmt.Fprintf(tw, "%s\t%.2f\t%+.3f\t%+.3f\t%+.3f\n", ablation.Component, weights[i],
+			ablation.Delta.PrecisionAtK, ablation.Delta.NDCG, ablation.Delta.Spearman)
+	}
+	return tw.Flush()
+}
+
+func init() {
+	evalCmd.Flags().IntVar(&evalFlags.k, "k", 5, "Cutoff for precision@k and NDCG@k")
+	evalCmd.Flags().Float64Var(&evalFlags.relevant, "relevant", 1, "Lowest label that counts as relevant for precision@k")
+	evalCmd.Flags().BoolVar(&evalFlags.jsonOutput, "json", false, "Print the report as JSON")
+	evalCmd.Flags().StringVar(&evalFlags.weightsFile, "weights", "", "JSON file with scoring weights or a trained scoring profile")
+	evalCmd.Flags().StringVar(&evalFlags.baselineFile, "baseline", "", "JSON report of an earlier run to compare against")
+	evalCmd.Flags().Float64Var(&evalFlags.recencyHalfLife, "recency-half-life", analysis.DefaultRecencyHalfLife, "Years after which an unused CV skill counts half (0 disables)")
+
+	rootCmd.AddCommand(evalCmd)
+}
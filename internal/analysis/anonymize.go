@@ -0,0 +1,247 @@
+package analysis
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Placeholders used when masking bias-prone attributes
+const (
+	maskedName     = "[CANDIDATE]"
+	maskedValue    = "[REDACTED]"
+	maskedYear     = "[YEAR]"
+	maskedImage    = "[IMAGE REMOVED]"
+	maskedAgeValue = "[AGE]"
+)
+
+var (
+	// Markdown images, HTML img tags and bare links to image files
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	htmlImagePattern     = regexp.MustCompile(`(?i)<img\b[^>]*>`)
+	imageLinkPattern     = regexp.MustCompile(`(?i)\bhttps?://\S+\.(?:jpe?g|png|gif|webp|bmp|heic)\b\S*`)
+
+	// Labelled personal fields, e.g. "Date of birth: 01.02.1990" or "Гражданство: РФ"
+	personalFieldPattern = regexp.MustCompile(`(?im)^(\s*(?:[-*]\s*)?(?:\*\*)?(?:date of birth|birth ?date|born|dob|age|nationality|citizenship|marital status|family status|gender|sex|дата рождения|возраст|гражданство|национальность|семейное положение|пол)(?:\*\*)?\s*[:：]\s*(?:\*\*)?\s*)(.+)$`)
+
+	// Name fields, e.g. "Name: Jane Doe" or "ФИО: Иванов Иван"
+	nameFieldPattern = regexp.MustCompile(`(?im)^(\s*(?:[-*]\s*)?(?:\*\*)?(?:full name|name|имя|фио)(?:\*\*)?\s*[:：]\s*(?:\*\*)?\s*)(.+)$`)
+
+	// Inline age and birth statements
+	ageStatementPattern  = regexp.MustCompile(`(?i)\b\d{2}\s*(?:years? old|y\.?o\.?)\b`)
+	bornStatementPattern = regexp.MustCompile(`(?i)\b(?:born(?: on| in)?|родил(?:ся|ась))\s+[\p{L}\d.,/ -]*?\b(?:19|20)\d{2}\b`)
+
+	// Marital status words used outside of labelled fields ("single" is too
+	// ambiguous in technical text, e.g. "single sign-on")
+	maritalPattern = regexp.MustCompile(`(?i)\b(?:married|divorced|widowed|женат|замужем|холост|разведен[ао]?)\b`)
+
+	// Honorifics that reveal gender
+	honorificPattern = regexp.MustCompile(`\b(?:Mr|Mrs|Ms|Miss)\.?\s+`)
+
+	// Years inside education sections (graduation years)
+	yearPattern = regexp.MustCompile(`\b(?:19|20)\d{2}\b`)
+
+	// Markdown headings
+	headingPattern = regexp.MustCompile(`^\s{0,3}(#{1,6})\s+(.*)$`)
+)
+
+// genderedPronouns maps gendered pronouns and gender words to neutral replacements. English
+// pronouns become the candidate placeholder, which needs no verb agreement ("[CANDIDATE] builds")
+// and reads the same whether "her" is an object or a possessive. Russian pronouns are left alone:
+// "он", "она", "его" and "её" also mean "it" and "its" ("его архитектура"), and past-tense verbs
+// reveal gender regardless.
+var genderedPronouns = map[string]string{
+	"he":      maskedName,
+	"she":     maskedName,
+	"him":     maskedName,
+	"her":     maskedName,
+	"his":     maskedName + "'s",
+	"hers":    maskedName + "'s",
+	"himself": maskedName,
+	"herself": maskedName,
+	"male":    maskedValue,
+	"female":  maskedValue,
+	"мужской": maskedValue,
+	"женский": maskedValue,
+}
+
+// roleWords are capitalized title words that are not part of a person's name
+var roleWords = map[string]bool{
+	"developer": true, "engineer": true, "manager": true, "designer": true,
+	"analyst": true, "architect": true, "consultant": true, "scientist": true,
+	"senior": true, "junior": true, "lead": true, "principal": true, "staff": true,
+	"software": true, "backend": true, "frontend": true, "fullstack": true,
+	"devops": true, "data": true, "product": true, "test": true, "qa": true,
+	"curriculum": true, "vitae": true, "разработчик": true, "инженер": true, "менеджер": true,
+}
+
+// pronounPattern matches any gendered pronoun as a whole word
+var pronounPattern = buildPronounPattern()
+
+func buildPronounPattern() *regexp.Regexp {
+	words := make([]string, 0, len(genderedPronouns))
+	for word := range genderedPronouns {
+		words = append(words, regexp.QuoteMeta(word))
+	}
+	// Longest alternatives first so "herself" wins over "her"
+	sort.Slice(words, func(i, j int) bool { return len(words[i]) > len(words[j]) })
+	return regexp.MustCompile(`(?i)(^|[^\p{L}])(` + strings.Join(words, "|") + `)([^\p{L}]|$)`)
+}
+
+// educationHeadings identifies sections whose years reveal graduation dates
+var educationHeadings = []string{"education", "academic", "qualifications", "образование", "учеба", "учёба"}
+
+// Anonymize masks bias-prone attributes in a CV for blind screening.
+// It strips candidate names, gendered pronouns, age and birth dates, photos and
+// image links, nationality, marital status and graduation years. Skills,
+// experience and other job-relevant content are left intact so the anonymized
+// text can still be scored.
+func Anonymize(content string) string {
+	names := detectCandidateNames(content)
+
+	content = markdownImagePattern.ReplaceAllString(content, maskedImage)
+	content = htmlImagePattern.ReplaceAllString(content, maskedImage)
+	content = imageLinkPattern.ReplaceAllString(content, maskedImage)
+
+	content = nameFieldPattern.ReplaceAllString(content, "${1}"+maskedName)
+	content = personalFieldPattern.ReplaceAllString(content, "${1}"+maskedValue)
+	content = ageStatementPattern.ReplaceAllString(content, maskedAgeValue)
+	content = bornStatementPattern.ReplaceAllString(content, maskedValue)
+	content = maritalPattern.ReplaceAllString(content, maskedValue)
+	content = honorificPattern.ReplaceAllString(content, "")
+
+	content = maskNames(content, names)
+	content = maskGraduationYears(content)
+	content = maskPronouns(content)
+
+	return content
+}
+
+// detectCandidateNames collects name tokens from the title heading and name fields
+func detectCandidateNames(content string) []string {
+	var names []string
+
+	for _, match := range nameFieldPattern.FindAllStringSubmatch(content, -1) {
+		for _, word := range strings.Fields(strings.Trim(match[2], "* ")) {
+			word = strings.Trim(word, ".,*")
+			if len([]rune(word)) >= 2 {
+				names = append(names, word)
+			}
+		}
+	}
+
+	if title := firstTitleHeading(content); title != "" {
+		// Titles like "Test CV - Ivan Developer" or "Jane Doe | Backend Engineer"
+		for _, part := range splitTitle(title) {
+			names = append(names, personNameTokens(part)...)
+		}
+	}
+
+	return names
+}
+
+// firstTitleHeading returns the text of the first level-one heading
+func firstTitleHeading(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		if m := headingPattern.FindStringSubmatch(line); m != nil && len(m[1]) == 1 {
+			return strings.TrimSpace(m[2])
+		}
+	}
+	return ""
+}
+
+// titleSeparatorPattern splits headings such as "Jane Doe - Backend Engineer"
+var titleSeparatorPattern = regexp.MustCompile(`\s+[-–—|]\s+`)
+
+// splitTitle splits a title heading into its dash/pipe separated parts
+func splitTitle(title string) []string {
+	parts := titleSeparatorPattern.Split(title, -1)
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// personNameTokens returns the name words of a heading part made of two to
+// four capitalized words, skipping job titles and words like "CV"
+func personNameTokens(text string) []string {
+	words := strings.Fields(text)
+	if len(words) < 2 || len(words) > 4 {
+		return nil
+	}
+	var tokens []string
+	for _, word := range words {
+		r := []rune(word)
+		if len(r) < 2 || !isUpperRune(r[0]) {
+			return nil
+		}
+		lower := strings.ToLower(word)
+		if lower == "cv" || lower == "resume" || lower == "резюме" {
+			return nil
+		}
+		if !roleWords[lower] {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// isUpperRune reports whether r is an uppercase letter
+func isUpperRune(r rune) bool {
+	return unicode.IsUpper(r)
+}
+
+// maskNames replaces every remaining occurrence of the detected name tokens. Matching is
+// case-sensitive, so a name like "Will" leaves the word "will" alone.
+func maskNames(content string, names []string) string {
+	for _, name := range names {
+		pattern := regexp.MustCompile(`(^|[^\p{L}])` + regexp.QuoteMeta(name) + `([^\p{L}]|$)`)
+		content = pattern.ReplaceAllString(content, "${1}"+maskedName+"${2}")
+	}
+	// Collapse "[CANDIDATE] [CANDIDATE]" left behind by first/last names
+	return strings.ReplaceAll(content, maskedName+" "+maskedName, maskedName)
+}
+
+// maskGraduationYears replaces years inside education sections
+func maskGraduationYears(content string) string {
+	lines := strings.Split(content, "\n")
+	inEducation := false
+	for i, line := range lines {
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			heading := strings.ToLower(m[2])
+			inEducation = false
+			for _, h := range educationHeadings {
+				if strings.Contains(heading, h) {
+					inEducation = true
+					break
+				}
+			}
+			continue
+		}
+		if inEducation {
+			lines[i] = yearPattern.ReplaceAllString(line, maskedYear)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// maskPronouns replaces gendered pronouns with neutral alternatives, keeping the capitalization of words
+func maskPronouns(content string) string {
+	replace := func(match string) string {
+		m := pronounPattern.FindStringSubmatch(match)
+		word := m[2]
+		replacement := genderedPronouns[strings.ToLower(word)]
+		if replacement != "" && !strings.HasPrefix(replacement, "[") && isUpperRune([]rune(word)[0]) {
+			r := []rune(replacement)
+			replacement = strings.ToUpper(string(r[0])) + string(r[1:])
+		}
+		return m[1] + replacement + m[3]
+	}
+	// Run twice: adjacent pronouns share the delimiter consumed by the first match
+	content = pronounPattern.ReplaceAllStringFunc(content, replace)
+	return pronounPattern.ReplaceAllStringFunc(content, replace)
+}
@@ -0,0 +1,135 @@
+package analysis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAnonymize_MasksPersonalAttributes(t *testing.T) {
+	cv := `# Jane Doe - Senior Backend Engineer
+
+![photo](https://example.com/jane.jpg)
+<img src="avatar.png" alt="me">
+
+- Date of birth: 12.03.1990
+- Age: 34
+- Nationality: Brazilian
+- Marital status: Married
+- Gender: Female
+
+## Summary
+Ms. Jane Doe is a 34 years old engineer. She has 8 years of Go experience and her team
+relies on her for Kubernetes work. Jane was born in 1990.
+
+## Education
+BS Computer Science - State University (2008-2012)
+
+## Experience
+- Software Engineer @ TechCorp (2018-2024)
+`
+
+	result := Anonymize(cv)
+
+	forbidden := []string{
+		"Jane", "Doe", "jane.jpg", "avatar.png", "1990", "Brazilian", "Married",
+		"Female", "She ", " her ", "34", "Ms.", "2008", "2012",
+	}
+	for _, f := range forbidden {
+		if strings.Contains(result, f) {
+			t.Errorf("anonymized CV still contains %q:\n%s", f, result)
+		}
+	}
+
+	// Job-relevant content must survive
+	kept := []string{"Senior Backend Engineer", "8 years of Go experience", "Kubernetes", "2018-2024", "State University"}
+	for _, k := range kept {
+		if !strings.Contains(result, k) {
+			t.Errorf("anonymized CV lost job-relevant text %q:\n%s", k, result)
+		}
+	}
+
+	if !strings.Contains(result, maskedName) {
+		t.Errorf("expected name placeholder in result:\n%s", result)
+	}
+}
+
+func TestAnonymize_NeutralPronouns(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"He led the team.", "[CANDIDATE] led the team."},
+		{"She builds Go services.", "[CANDIDATE] builds Go services."},
+		{"She built it herself.", "[CANDIDATE] built it [CANDIDATE]."},
+		{"Managers trust him.", "Managers trust [CANDIDATE]."},
+		{"Managers trust her.", "Managers trust [CANDIDATE]."},
+		{"his work", "[CANDIDATE]'s work"},
+		{"Single sign-on with Keycloak", "Single sign-on with Keycloak"},
+		{"the heap and shell scripts", "the heap and shell scripts"},
+	}
+
+	for _, tt := range tests {
+		if got := Anonymize(tt.input); got != tt.expected {
+			t.Errorf("Anonymize(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestAnonymize_Russian(t *testing.T) {
+	cv := `# Иванов Иван
+
+- ФИО: Иванов Иван Петрович
+- Дата рождения: 01.01.1985
+- Гражданство: РФ
+- Семейное положение: женат
+
+## Образование
+МГУ, 2007
+
+## Опыт
+Разработал платформу на Go. Его архитектура выдержала рост нагрузки.
+`
+	result := Anonymize(cv)
+
+	for _, f := range []string{"Иванов", "Петрович", "1985", "РФ", "женат", "2007"} {
+		if strings.Contains(result, f) {
+			t.Errorf("anonymized CV still contains %q:\n%s", f, result)
+		}
+	}
+	// "Его" also means "its" and is not rewritten
+	if !strings.Contains(result, "платформу на Go") || !strings.Contains(result, "Его архитектура") {
+		t.Errorf("anonymized CV lost job-relevant text:\n%s", result)
+	}
+}
+
+func TestAnonymize_NamesMatchCase(t *testing.T) {
+	cv := "# Will Smith\n\n## Summary\nWill Smith will migrate services and will mentor juniors.\n"
+	result := Anonymize(cv)
+
+	if strings.Contains(result, "Will") || strings.Contains(result, "Smith") {
+		t.Errorf("anonymized CV still contains the name:\n%s", result)
+	}
+	if !strings.Contains(result, "[CANDIDATE] will migrate services and will mentor juniors.") {
+		t.Errorf("expected the word \"will\" to be kept:\n%s", result)
+	}
+}
+
+func TestAnonymize_PreservesSkills(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "testdata", "cv.md"))
+	if err != nil {
+		t.Fatalf("failed to read testdata CV: %v", err)
+	}
+
+	original := ExtractSkills(ctx, string(content), sd)
+	anonymized := ExtractSkills(ctx, Anonymize(string(content)), sd)
+
+	if len(anonymized) != len(original) {
+		t.Errorf("anonymization changed extracted skills: before %v, after %v", original, anonymized)
+	}
+}
@@ -0,0 +1,72 @@
+package analysis
+
+import "math"
+
+// MinCalibrationSamples is the fewest recorded applicants a score is calibrated against
+const MinCalibrationSamples = 5
+
+// Calibration scopes
+const (
+	CalibrationScopeJD     = "jd"     // Applicants to the same JD
+	CalibrationScopeFamily = "family" // Applicants to any JD of the same family
+)
+
+// ScoreCalibration places a weighted score in the distribution of scores of other applicants
+type ScoreCalibration struct {
+	Scope      string  `json:"scope"`           // CalibrationScopeJD or CalibrationScopeFamily
+	Group      string  `json:"group,omitempty"` // JD URI or family name
+	Applicants int     `json:"applicants"`      // Scores the calibration is based on, this one included
+	Percentile float64 `json:"percentile"`      // Share of applicants scoring lower, ties counting half, 0-100
+	TopPercent float64 `json:"top_percent"`     // Share of applicants scoring the same or higher, 0-100
+}
+
+// ScoreHistogram counts the latest weighted score (0-100) of each applicant, so percentiles can be
+// updated one analysis at a time. Recording an applicant again replaces their earlier score.
+type ScoreHistogram struct {
+	Counts [101]int       `json:"counts"`
+	Scores map[string]int `json:"scores"` // Latest score per applicant
+}
+
+// Record adds or replaces an applicant's score
+func (h *ScoreHistogram) Record(applicant string, score int) {
+	score = clampScore(score)
+	if h.Scores == nil {
+		h.Scores = make(map[string]int)
+	}
+	if previous, ok := h.Scores[applicant]; ok {
+		h.Counts[previous]--
+	}
+	h.Scores[applicant] = score
+	h.Counts[score]++
+}
+
+// Total returns the number of applicants recorded
+func (h *ScoreHistogram) Total() int {
+	return len(h.Scores)
+}
+
+// Calibrate returns where a score falls among the recorded ones, or false when fewer than
+// MinCalibrationSamples applicants have been recorded
+func (h *ScoreHistogram) Calibrate(score int) (ScoreCalibration, bool) {
+	total := h.Total()
+	if total < MinCalibrationSamples {
+		return ScoreCalibration{}, false
+	}
+	score = clampScore(score)
+
+	below := 0
+	for s := 0; s < score; s++ {
+		below += h.Counts[s]
+	}
+	equal := h.Counts[score]
+	return ScoreCalibration{
+		Applicants: total,
+		Percentile: roundTenth(100 * (float64(below) + float64(equal)/2) / float64(total)),
+		TopPercent: roundTenth(100 * float64(total-below) / float64(total)),
+	}, true
+}
+
+// clampScore limits a score to 0-100
+func clampScore(score int) int {
+	return int(math.Min(math.Max(float64(score), 0), 100))
+}
@@ -0,0 +1,53 @@
+package analysis
+
+import "testing"
+
+func TestScoreHistogram_Calibrate(t *testing.T) {
+	var h ScoreHistogram
+	for i, score := range []int{20, 40, 40, 60, 80} {
+		h.Record(string(rune('a'+i)), score)
+	}
+
+	tests := []struct {
+		score      int
+		percentile float64
+		topPercent float64
+	}{
+		{80, 90, 20},
+		{60, 70, 40},
+		{40, 40, 80},
+		{20, 10, 100},
+		{100, 100, 0},
+	}
+	for _, tt := range tests {
+		c, ok := h.Calibrate(tt.score)
+		if !ok {
+			t.Fatalf("Expected a calibration with %d applicants", h.Total())
+		}
+		if c.Applicants != 5 || !almostEqual(c.Percentile, tt.percentile, 0.001) || !almostEqual(c.TopPercent, tt.topPercent, 0.001) {
+			t.Errorf("Score %d: expected percentile %v and top %v%%, got %+v", tt.score, tt.percentile, tt.topPercent, c)
+		}
+	}
+}
+
+func TestScoreHistogram_Record(t *testing.T) {
+	var h ScoreHistogram
+	for i := 0; i < MinCalibrationSamples-1; i++ {
+		h.Record(string(rune('a'+i)), 50)
+	}
+	if _, ok := h.Calibrate(50); ok {
+		t.Errorf("Expected no calibration below %d applicants", MinCalibrationSamples)
+	}
+
+	// Recording an applicant again replaces the earlier score
+	h.Record("a", 90)
+	if h.Total() != MinCalibrationSamples-1 || h.Counts[50] != MinCalibrationSamples-2 || h.Counts[90] != 1 {
+		t.Errorf("Expected the score to be replaced, got %d applicants, counts %d/%d", h.Total(), h.Counts[50], h.Counts[90])
+	}
+
+	// Out-of-range scores are clamped
+	h.Record("z", 150)
+	if h.Counts[100] != 1 {
+		t.Errorf("Expected a score above 100 to count as 100")
+	}
+}
@@ -0,0 +1,491 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Work modes a JD offers or a CV accepts
+const (
+	WorkModeRemote = "remote"
+	WorkModeHybrid = "hybrid"
+	WorkModeOnsite = "onsite"
+)
+
+// Check outcomes shared by constraint checks and screening rules
+const (
+	CheckPass   = "pass"
+	CheckFail   = "fail"
+	CheckReview = "needs_review"
+)
+
+// Relocation and sponsorship stances. For a JD "yes" means support is offered;
+// for a CV it means the candidate is willing to relocate or needs sponsorship.
+const (
+	StanceYes = "yes"
+	StanceNo  = "no"
+)
+
+// defaultTimezoneTolerance is how many hours outside a JD's timezone range still overlap enough
+const defaultTimezoneTolerance = 3
+
+var (
+	// timezonePattern matches "UTC+3", "GMT-5", "UTC+5:30" and common zone abbreviations
+	timezonePattern = regexp.MustCompile(`\b(?i:utc|gmt)\s*(?:([+−-])\s*(\d{1,2})(?::?(\d{2}))?)?|\b(CET|CEST|EET|EEST|WET|MSK|EST|EDT|CST|CDT|MST|PST|PDT|BRT)\b`)
+	// timezoneTolerancePattern matches "±2 hours", "+/- 2h", "± 3 ч"
+	timezoneTolerancePattern = regexp.MustCompile(`(?:±|\+/-|\+-)\s*(\d{1,2})\s*(?:h|hrs?|hours?|ч|час)`)
+
+	// zoneOffsets are the UTC offsets of zone abbreviations
+	zoneOffsets = map[string]float64{
+		"WET": 0, "CET": 1, "CEST": 2, "EET": 2, "EEST": 3, "MSK": 3,
+		"EST": -5, "EDT": -4, "CST": -6, "CDT": -5, "MST": -7, "PST": -8, "PDT": -7, "BRT": -3,
+	}
+
+	// locationCues mark a sentence as stating where someone is or where the job is
+	locationCues = map[string]bool{
+		"based": true, "located": true, "living": true, "live": true, "reside": true, "residing": true,
+		"location": true, "office": true, "relocate": true, "relocation": true,
+		"проживаю": true, "нахожусь": true, "живу": true, "город": true, "локация": true, "офис": true, "офисе": true,
+	}
+	// preferenceCues mark a CV sentence as stating the candidate's preference ("open to remote")
+	preferenceCues = map[string]bool{
+		"only": true, "prefer": true, "preferred": true, "preference": true, "looking": true, "open": true,
+		"seeking": true, "available": true, "format": true, "remote-only": true,
+		"только": true, "рассматриваю": true, "ищу": true, "готов": true, "формат": true, "предпочитаю": true,
+	}
+	// authorizationCues mark statements of the right to work somewhere ("EU citizen", "authorized to work in the US")
+	authorizationCues = map[string]bool{
+		"authorized": true, "authorised": true, "authorization": true, "authorisation": true, "permit": true,
+		"citizen": true, "citizenship": true, "resident": true, "residency": true,
+		"гражданство": true, "гражданин": true, "гражданка": true, "внж": true, "резидент": true, "разрешение": true,
+	}
+	// unavailableWords follow "not" when an arrangement is ruled out ("remote work is not possible")
+	unavailableWords = map[string]bool{
+		"possible": true, "available": true, "allowed": true, "offered": true, "supported": true, "an": true,
+		"возможна": true, "возможно": true, "предусмотрена": true, "предусмотрено": true, "рассматривается": true,
+	}
+)
+
+// TimezoneRange is the range of UTC offsets stated in a document
+type TimezoneRange struct {
+	Min       float64 `json:"min"`
+	Max       float64 `json:"max"`
+	Tolerance float64 `json:"tolerance,omitempty"` // Extra hours a JD accepts outside the range
+}
+
+// WorkConstraints are the location and work-arrangement facts stated in a CV or JD
+type WorkConstraints struct {
+	Location     *Place         `json:"location,omitempty"`
+	Timezone     *TimezoneRange `json:"timezone,omitempty"`
+	WorkModes    []string       `json:"work_modes,omitempty"`  // JD: modes offered; CV: modes accepted
+	Relocation   string         `json:"relocation,omitempty"`  // StanceYes or StanceNo
+	Sponsorship  string         `json:"sponsorship,omitempty"` // StanceYes or StanceNo
+	AuthorizedIn []Place        `json:"authorized_in,omitempty"`
+}
+
+// ConstraintCheck is the outcome of comparing one constraint between a CV and a JD
+type ConstraintCheck struct {
+	Constraint string `json:"constraint"` // "work_mode", "location", "timezone" or "sponsorship"
+	JD         string `json:"jd"`
+	CV         string `json:"cv"`
+	Status     string `json:"status"` // CheckPass, CheckFail or CheckReview
+	Reason     string `json:"reason"`
+}
+
+// constraintSentence is one sentence split into lowercase and original words
+type constraintSentence struct {
+	text     string
+	words    []string
+	original []string
+}
+
+// splitConstraintSentences splits text into sentences of words
+func splitConstraintSentences(content string) []constraintSentence {
+	var sentences []constraintSentence
+	for _, text := range sentencePattern.Split(content, -1) {
+		spans := wordSpans(text)
+		s := constraintSentence{text: text, words: make([]string, len(spans)), original: make([]string, len(spans))}
+		for i, span := range spans {
+			s.original[i] = text[span[0]:span[1]]
+			s.words[i] = strings.ToLower(s.original[i])
+		}
+		sentences = append(sentences, s)
+	}
+	return sentences
+}
+
+// hasCue reports whether any word is in cues
+func (s constraintSentence) hasCue(cues map[string]bool) bool {
+	for _, word := range s.words {
+		if cues[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// workModeAt returns the work mode named at words[i] ("remote", "on-site", "in the office", "удалённо")
+func workModeAt(words []string, i int) string {
+	word, prev := words[i], ""
+	if i > 0 {
+		prev = words[i-1]
+	}
+	switch {
+	case word == "remote" || word == "remotely" || word == "remote-first" || word == "remote-only" || word == "wfh" ||
+		strings.HasPrefix(word, "удален") || strings.HasPrefix(word, "удалён") || word == "home" && prev == "from":
+		return WorkModeRemote
+	case word == "hybrid" || strings.HasPrefix(word, "гибрид"):
+		return WorkModeHybrid
+	case word == "onsite" || word == "on-site" || word == "in-office" || word == "office-based" ||
+		word == "site" && prev == "on" || word == "office" && (prev == "in" || prev == "the" && i > 1 && words[i-2] == "in") ||
+		strings.HasPrefix(word, "офис") && (prev == "в" || prev == "из"):
+		return WorkModeOnsite
+	}
+	return ""
+}
+
+// constraintNegated reports whether the arrangement at words[i] is ruled out
+// ("no remote", "remote work is not possible", "we are unable to sponsor visas")
+func constraintNegated(words []string, i int) bool {
+	flags := make([]bool, len(words))
+	if negatedBefore(words, flags, i) || negatedAfter(words, i) {
+		return true
+	}
+	for k := i - 1; k >= 0 && k >= i-3; k-- {
+		if words[k] == "unable" {
+			return true
+		}
+	}
+	for k := i + 1; k < len(words)-1 && k <= i+4; k++ {
+		if contrastWords[words[k]] {
+			return false
+		}
+		if negationCues[words[k]] && unavailableWords[words[k+1]] {
+			return true
+		}
+	}
+	return false
+}
+
+// relocationWord reports whether a word talks about relocating (not "relocated", which is history)
+func relocationWord(word string) bool {
+	return word == "relocate" || word == "relocation" || word == "relocating" || strings.HasPrefix(word, "переезд")
+}
+
+// sponsorshipWord reports whether a word talks about visa sponsorship
+func sponsorshipWord(word string) bool {
+	return strings.HasPrefix(word, "sponsor") || word == "visa" || word == "visas" || strings.HasPrefix(word, "виз")
+}
+
+// stanceAt returns StanceNo for a ruled-out mention ("relocation: no", "no visa sponsorship"), else StanceYes
+func stanceAt(words []string, i int) string {
+	if constraintNegated(words, i) || i+1 < len(words) && (words[i+1] == "no" || words[i+1] == "нет") {
+		return StanceNo
+	}
+	return StanceYes
+}
+
+// ExtractJDConstraints extracts the location, timezone, work modes, relocation support and
+// visa sponsorship a job description states
+func ExtractJDConstraints(content string, jd *parse.JobDescription) WorkConstraints {
+	var c WorkConstraints
+	if jd.Location != "" {
+		c.Location = firstPlace(jd.Location)
+		c.WorkModes = workModes(splitConstraintSentences(jd.Location), nil)
+	}
+
+	sentences := splitConstraintSentences(content)
+	c.WorkModes = appendUnique(c.WorkModes, workModes(sentences, nil)...)
+	c.Timezone = timezoneRange(content, defaultTimezoneTolerance)
+
+	for _, s := range sentences {
+		if c.Location == nil && (s.hasCue(locationCues) || len(workModes([]constraintSentence{s}, nil)) > 0) {
+			if places := findPlaces(s.words, s.original); len(places) > 0 {
+				c.Location = &places[0]
+			}
+		}
+		for i, word := range s.words {
+			switch {
+			case relocationWord(word) && c.Relocation == "":
+				c.Relocation = stanceAt(s.words, i)
+			case sponsorshipWord(word) && c.Sponsorship == "":
+				c.Sponsorship = stanceAt(s.words, i)
+			}
+		}
+		// "Must be authorized to work in the EU" means no sponsorship
+		if c.Sponsorship == "" && s.hasCue(authorizationCues) && s.hasCue(requirementCues) {
+			c.Sponsorship = StanceNo
+		}
+	}
+	return c
+}
+
+// ExtractCVConstraints extracts the candidate's location, timezone, accepted work modes,
+// willingness to relocate, sponsorship needs and work authorizations
+func ExtractCVConstraints(content string, cv *parse.CV) WorkConstraints {
+	var c WorkConstraints
+	if cv.Contact.Location != "" {
+		c.Location = firstPlace(cv.Contact.Location)
+	}
+
+	sentences := splitConstraintSentences(content)
+	// Only stated preferences count, not "worked in a fully remote team"
+	c.WorkModes = workModes(sentences, preferenceCues)
+
+	for _, s := range sentences {
+		authorization := s.hasCue(authorizationCues)
+		if authorization {
+			c.AuthorizedIn = append(c.AuthorizedIn, findPlaces(s.words, s.original)...)
+		} else if c.Location == nil && s.hasCue(locationCues) {
+			if places := findPlaces(s.words, s.original); len(places) > 0 {
+				c.Location = &places[0]
+			}
+		}
+		for i, word := range s.words {
+			switch {
+			case relocationWord(word) && c.Relocation == "":
+				c.Relocation = stanceAt(s.words, i)
+			case sponsorshipWord(word) && c.Sponsorship == "" && !authorization:
+				c.Sponsorship = stanceAt(s.words, i)
+			}
+		}
+	}
+
+	c.Timezone = timezoneRange(content, 0)
+	if c.Timezone == nil && c.Location != nil && c.Location.Country != "" {
+		c.Timezone = &TimezoneRange{Min: c.Location.Offset, Max: c.Location.Offset}
+	}
+	return c
+}
+
+// firstPlace returns the first gazetteer place in text, or nil
+func firstPlace(text string) *Place {
+	for _, s := range splitConstraintSentences(text) {
+		if places := findPlaces(s.words, s.original); len(places) > 0 {
+			return &places[0]
+		}
+	}
+	return nil
+}
+
+// workModes collects affirmative work mode mentions, from sentences with a cue when cues is set
+func workModes(sentences []constraintSentence, cues map[string]bool) []string {
+	var modes []string
+	for _, s := range sentences {
+		if cues != nil && !s.hasCue(cues) {
+			continue
+		}
+		for i := range s.words {
+			if mode := workModeAt(s.words, i); mode != "" && !constraintNegated(s.words, i) {
+				modes = appendUnique(modes, mode)
+			}
+		}
+	}
+	return modes
+}
+
+// timezoneRange returns the range of UTC offsets stated in text, or nil.
+// A stated "±N hours" overrides the default tolerance.
+func timezoneRange(text string, tolerance float64) *TimezoneRange {
+	matches := timezonePattern.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	r := &TimezoneRange{Min: math.Inf(1), Max: math.Inf(-1), Tolerance: tolerance}
+	for _, m := range matches {
+		offset := zoneOffsets[m[4]]
+		if m[2] != "" {
+			hours, _ := strconv.Atoi(m[2])
+			minutes, _ := strconv.Atoi(m[3])
+			offset = float64(hours) + float64(minutes)/60
+			if m[1] != "+" {
+				offset = -offset
+			}
+		}
+		r.Min = math.Min(r.Min, offset)
+		r.Max = math.Max(r.Max, offset)
+	}
+	if m := timezoneTolerancePattern.FindStringSubmatch(text); m != nil {
+		hours, _ := strconv.Atoi(m[1])
+		r.Tolerance = float64(hours)
+	}
+	return r
+}
+
+// appendUnique appends values not already in list
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		if !containsString(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// CheckConstraints compares CV and JD work constraints and marks incompatible pairs.
+// Only constraints both documents say something about are checked.
+func CheckConstraints(cv, jd WorkConstraints) []ConstraintCheck {
+	var checks []ConstraintCheck
+	if check, ok := checkWorkMode(cv, jd); ok {
+		checks = append(checks, check)
+	}
+	if check, ok := checkLocation(cv, jd); ok {
+		checks = append(checks, check)
+	}
+	if check, ok := checkTimezone(cv, jd); ok {
+		checks = append(checks, check)
+	}
+	if check, ok := checkSponsorship(cv, jd); ok {
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// ConstraintsMet reports whether no constraint check failed
+func ConstraintsMet(checks []ConstraintCheck) bool {
+	for _, check := range checks {
+		if check.Status == CheckFail {
+			return false
+		}
+	}
+	return true
+}
+
+// requiresPresence reports whether a JD needs the candidate in its location (onsite or hybrid)
+func (c WorkConstraints) requiresPresence() bool {
+	return c.Location != nil && !containsString(c.WorkModes, WorkModeRemote)
+}
+
+// checkWorkMode passes when the CV accepts one of the JD's work modes; accepting onsite includes hybrid
+func checkWorkMode(cv, jd WorkConstraints) (ConstraintCheck, bool) {
+	if len(cv.WorkModes) == 0 || len(jd.WorkModes) == 0 {
+		return ConstraintCheck{}, false
+	}
+	check := ConstraintCheck{Constraint: "work_mode", JD: strings.Join(jd.WorkModes, "/"), CV: strings.Join(cv.WorkModes, "/")}
+
+	accepted := cv.WorkModes
+	if containsString(accepted, WorkModeOnsite) {
+		accepted = appendUnique(append([]string(nil), accepted...), WorkModeHybrid)
+	}
+	for _, mode := range jd.WorkModes {
+		if containsString(accepted, mode) {
+			check.Status, check.Reason = CheckPass, "CV accepts "+mode
+			return check, true
+		}
+	}
+	check.Status, check.Reason = CheckFail, fmt.Sprintf("JD is %s, CV accepts %s only", check.JD, check.CV)
+	return check, true
+}
+
+// checkLocation passes when the candidate is already in the JD location or willing to relocate.
+// Remote roles limited to a place are only flagged for review, since such limits are often loose.
+func checkLocation(cv, jd WorkConstraints) (ConstraintCheck, bool) {
+	if cv.Location == nil || jd.Location == nil {
+		return ConstraintCheck{}, false
+	}
+	check := ConstraintCheck{Constraint: "location", JD: jd.Location.Name, CV: cv.Location.Name}
+
+	switch {
+	case cv.Location.within(*jd.Location):
+		check.Status, check.Reason = CheckPass, "CV is based in "+jd.Location.Name
+	case !jd.requiresPresence():
+		check.Status, check.Reason = CheckReview, fmt.Sprintf("remote role listed for %s, CV is based in %s", jd.Location.Name, cv.Location.Name)
+	case cv.Relocation == StanceYes:
+		check.Status, check.Reason = CheckPass, "CV is willing to relocate"
+	case cv.Relocation == StanceNo:
+		check.Status, check.Reason = CheckFail, fmt.Sprintf("JD requires presence in %s, CV is not willing to relocate from %s", jd.Location.Name, cv.Location.Name)
+	default:
+		check.Status, check.Reason = CheckReview, fmt.Sprintf("JD requires presence in %s, CV is based in %s and does not mention relocation", jd.Location.Name, cv.Location.Name)
+	}
+	return check, true
+}
+
+// checkTimezone passes when the CV's UTC offset is within the JD's range plus tolerance
+func checkTimezone(cv, jd WorkConstraints) (ConstraintCheck, bool) {
+	if cv.Timezone == nil || jd.Timezone == nil {
+		return ConstraintCheck{}, false
+	}
+	check := ConstraintCheck{Constraint: "timezone", JD: formatOffsets(*jd.Timezone), CV: formatOffsets(*cv.Timezone)}
+
+	distance := math.Max(0, math.Max(jd.Timezone.Min-cv.Timezone.Max, cv.Timezone.Min-jd.Timezone.Max))
+	if distance <= jd.Timezone.Tolerance {
+		check.Status, check.Reason = CheckPass, "timezones overlap"
+	} else {
+		check.Status, check.Reason = CheckFail, fmt.Sprintf("CV is %g hours outside the JD timezone range", distance)
+	}
+	return check, true
+}
+
+// checkSponsorship checks whether a candidate outside the JD country can legally take an onsite role
+func checkSponsorship(cv, jd WorkConstraints) (ConstraintCheck, bool) {
+	if !jd.requiresPresence() || jd.Location.Country == "" && jd.Location.Region == "" {
+		return ConstraintCheck{}, false
+	}
+	area := *jd.Location
+	if area.Country != "" {
+		area = countries[area.Country]
+		area.Name, area.Country = jd.Location.Country, jd.Location.Country
+	}
+	check := ConstraintCheck{Constraint: "sponsorship", JD: stanceLabel(jd.Sponsorship, "offered", "not offered"), CV: stanceLabel(cv.Sponsorship, "needed", "not needed")}
+
+	authorized := cv.Location != nil && cv.Location.within(area)
+	for _, place := range cv.AuthorizedIn {
+		authorized = authorized || area.within(place)
+	}
+
+	switch {
+	case authorized:
+		check.Status, check.Reason = CheckPass, "CV is authorized to work in "+area.Name
+	case cv.Sponsorship == StanceNo:
+		check.Status, check.Reason = CheckPass, "CV does not need sponsorship"
+	case jd.Sponsorship == StanceYes:
+		check.Status, check.Reason = CheckPass, "JD offers sponsorship"
+	case cv.Sponsorship == StanceYes && jd.Sponsorship == StanceNo:
+		check.Status, check.Reason = CheckFail, "CV needs visa sponsorship, JD offers none"
+	case cv.Sponsorship == StanceYes:
+		check.Status, check.Reason = CheckReview, "CV needs visa sponsorship, JD does not say whether it sponsors"
+	case jd.Sponsorship == StanceNo && cv.Location != nil:
+		check.Status, check.Reason = CheckReview, fmt.Sprintf("CV is based in %s without stated authorization for %s, JD offers no sponsorship", cv.Location.Name, area.Name)
+	default:
+		return ConstraintCheck{}, false
+	}
+	return check, true
+}
+
+// stanceLabel describes a stance for reports
+func stanceLabel(stance, yes, no string) string {
+	switch stance {
+	case StanceYes:
+		return yes
+	case StanceNo:
+		return no
+	default:
+		return "unspecified"
+	}
+}
+
+// formatOffsets renders a timezone range as "UTC+1" or "UTC+1..UTC+3 (±2h)"
+func formatOffsets(r TimezoneRange) string {
+	text := formatOffset(r.Min)
+	if r.Max != r.Min {
+		text += ".." + formatOffset(r.Max)
+	}
+	if r.Tolerance > 0 {
+		text += fmt.Sprintf(" (±%gh)", r.Tolerance)
+	}
+	return text
+}
+
+// formatOffset renders a UTC offset as "UTC+5.5" or "UTC-3"
+func formatOffset(offset float64) string {
+	if offset < 0 {
+		return fmt.Sprintf("UTC%g", offset)
+	}
+	return fmt.Sprintf("UTC+%g", offset)
+}
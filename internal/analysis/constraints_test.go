@@ -0,0 +1,204 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+const onsiteBerlinJD = `# Backend Engineer
+
+Location: Berlin
+
+## Requirements
+- 5+ years of Go
+- This is an onsite role in our Berlin office
+- We are unable to sponsor visas; candidates must be authorized to work in the EU
+
+## Benefits
+- Relocation support within Germany
+`
+
+const remoteBrazilCV = `# Ana Souza
+Location: São Paulo, Brazil
+
+## Summary
+Backend engineer. Looking for remote only roles, not open to relocation.
+`
+
+func TestExtractJDConstraints(t *testing.T) {
+	c := ExtractJDConstraints(onsiteBerlinJD, parse.ParseJD(onsiteBerlinJD))
+
+	if c.Location == nil || c.Location.Name != "Berlin" || c.Location.Country != "Germany" {
+		t.Errorf("Expected Berlin, Germany, got %+v", c.Location)
+	}
+	if !reflect.DeepEqual(c.WorkModes, []string{WorkModeOnsite}) {
+		t.Errorf("Expected onsite, got %v", c.WorkModes)
+	}
+	if c.Sponsorship != StanceNo {
+		t.Errorf("Expected no sponsorship, got %q", c.Sponsorship)
+	}
+	if c.Relocation != StanceYes {
+		t.Errorf("Expected relocation support, got %q", c.Relocation)
+	}
+}
+
+func TestExtractCVConstraints(t *testing.T) {
+	c := ExtractCVConstraints(remoteBrazilCV, parse.ParseCV(remoteBrazilCV))
+
+	if c.Location == nil || c.Location.Name != "São Paulo" || c.Location.Country != "Brazil" {
+		t.Errorf("Expected São Paulo, Brazil, got %+v", c.Location)
+	}
+	if !reflect.DeepEqual(c.WorkModes, []string{WorkModeRemote}) {
+		t.Errorf("Expected remote only, got %v", c.WorkModes)
+	}
+	if c.Relocation != StanceNo {
+		t.Errorf("Expected unwilling to relocate, got %q", c.Relocation)
+	}
+	if c.Timezone == nil || c.Timezone.Min != -3 {
+		t.Errorf("Expected timezone from location (UTC-3), got %+v", c.Timezone)
+	}
+}
+
+func TestExtractConstraints_Phrases(t *testing.T) {
+	tests := []struct {
+		content     string
+		modes       []string
+		relocation  string
+		sponsorship string
+	}{
+		{"Fully remote, visa sponsorship available", []string{WorkModeRemote}, "", StanceYes},
+		{"Hybrid: 3 days in the office", []string{WorkModeHybrid, WorkModeOnsite}, "", ""},
+		{"Remote work is not possible", nil, "", ""},
+		{"No relocation package. We do not sponsor visas", nil, StanceNo, StanceNo},
+		{"Формат работы: удалённо или гибрид", []string{WorkModeRemote, WorkModeHybrid}, "", ""},
+		{"Работа в офисе, помощь с переездом", []string{WorkModeOnsite}, StanceYes, ""},
+	}
+
+	for _, tt := range tests {
+		c := ExtractJDConstraints(tt.content, parse.ParseJD(tt.content))
+		if !reflect.DeepEqual(c.WorkModes, tt.modes) {
+			t.Errorf("For %q, expected modes %v, got %v", tt.content, tt.modes, c.WorkModes)
+		}
+		if c.Relocation != tt.relocation || c.Sponsorship != tt.sponsorship {
+			t.Errorf("For %q, expected relocation %q and sponsorship %q, got %q and %q",
+				tt.content, tt.relocation, tt.sponsorship, c.Relocation, c.Sponsorship)
+		}
+	}
+}
+
+func TestTimezoneRange(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected *TimezoneRange
+	}{
+		{"Team works in CET ±2 hours", &TimezoneRange{Min: 1, Max: 1, Tolerance: 2}},
+		{"Overlap with UTC+1 to UTC+3", &TimezoneRange{Min: 1, Max: 3, Tolerance: 3}},
+		{"Based in India (UTC+5:30)", &TimezoneRange{Min: 5.5, Max: 5.5, Tolerance: 3}},
+		{"Must overlap with EST", &TimezoneRange{Min: -5, Max: -5, Tolerance: 3}},
+		{"Best practices were established", nil},
+	}
+
+	for _, tt := range tests {
+		if result := timezoneRange(tt.text, defaultTimezoneTolerance); !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("timezoneRange(%q) = %+v, want %+v", tt.text, result, tt.expected)
+		}
+	}
+}
+
+func TestCheckConstraints_Incompatible(t *testing.T) {
+	cv := ExtractCVConstraints(remoteBrazilCV, parse.ParseCV(remoteBrazilCV))
+	jd := ExtractJDConstraints(onsiteBerlinJD, parse.ParseJD(onsiteBerlinJD))
+	checks := CheckConstraints(cv, jd)
+
+	statuses := make(map[string]string)
+	for _, check := range checks {
+		statuses[check.Constraint] = check.Status
+	}
+	expected := map[string]string{"work_mode": CheckFail, "location": CheckFail, "sponsorship": CheckReview}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("Expected %v, got %+v", expected, checks)
+	}
+	if ConstraintsMet(checks) {
+		t.Error("Expected constraints not to be met")
+	}
+}
+
+func TestCheckConstraints(t *testing.T) {
+	berlin, _ := lookupPlace("berlin")
+	munich, _ := lookupPlace("munich")
+	lisbon, _ := lookupPlace("lisbon")
+	eu, _ := lookupPlace("eu")
+
+	tests := []struct {
+		name       string
+		cv, jd     WorkConstraints
+		constraint string
+		status     string
+	}{
+		{
+			name:       "hybrid accepted by onsite candidate",
+			cv:         WorkConstraints{WorkModes: []string{WorkModeOnsite}},
+			jd:         WorkConstraints{WorkModes: []string{WorkModeHybrid}},
+			constraint: "work_mode",
+			status:     CheckPass,
+		},
+		{
+			name:       "same city",
+			cv:         WorkConstraints{Location: &berlin},
+			jd:         WorkConstraints{Location: &berlin, WorkModes: []string{WorkModeOnsite}},
+			constraint: "location",
+			status:     CheckPass,
+		},
+		{
+			name:       "other city, willing to relocate",
+			cv:         WorkConstraints{Location: &lisbon, Relocation: StanceYes},
+			jd:         WorkConstraints{Location: &berlin},
+			constraint: "location",
+			status:     CheckPass,
+		},
+		{
+			name:       "remote role limited to the EU",
+			cv:         WorkConstraints{Location: &lisbon},
+			jd:         WorkConstraints{Location: &eu, WorkModes: []string{WorkModeRemote}},
+			constraint: "location",
+			status:     CheckPass,
+		},
+		{
+			name:       "timezone outside tolerance",
+			cv:         WorkConstraints{Timezone: &TimezoneRange{Min: -5, Max: -5}},
+			jd:         WorkConstraints{Timezone: &TimezoneRange{Min: 1, Max: 2, Tolerance: 3}},
+			constraint: "timezone",
+			status:     CheckFail,
+		},
+		{
+			name:       "sponsorship needed and not offered",
+			cv:         WorkConstraints{Sponsorship: StanceYes},
+			jd:         WorkConstraints{Location: &berlin, Sponsorship: StanceNo},
+			constraint: "sponsorship",
+			status:     CheckFail,
+		},
+		{
+			name:       "EU authorization covers Germany",
+			cv:         WorkConstraints{Location: &lisbon, AuthorizedIn: []Place{eu}},
+			jd:         WorkConstraints{Location: &munich, Sponsorship: StanceNo},
+			constraint: "sponsorship",
+			status:     CheckPass,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, check := range CheckConstraints(tt.cv, tt.jd) {
+				if check.Constraint == tt.constraint {
+					if check.Status != tt.status {
+						t.Errorf("Expected %s, got %+v", tt.status, check)
+					}
+					return
+				}
+			}
+			t.Errorf("Expected a %s check", tt.constraint)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package analysis
+
+import (
+	"regexp"
+	"strings"
+)
+
+// negationWindow is how many words before a skill a negation cue still applies to it
+const negationWindow = 5
+
+// postNegationWindow is how many words after a skill "is not required" may start
+const postNegationWindow = 3
+
+// sentencePattern splits text into sentences at line breaks and sentence punctuation
+var sentencePattern = regexp.MustCompile(`\n|[.!?;](?:\s|$)`)
+
+var (
+	// negationCues negate the skills that follow them ("no experience with Java", "без опыта PHP")
+	negationCues = map[string]bool{
+		"no": true, "not": true, "without": true, "never": true, "none": true, "neither": true, "nor": true,
+		"lack": true, "lacks": true, "lacking": true, "don't": true, "doesn't": true, "didn't": true,
+		"haven't": true, "hasn't": true, "isn't": true, "aren't": true, "cannot": true, "can't": true,
+		"не": true, "нет": true, "без": true, "никогда": true, "ни": true, "отсутствует": true,
+	}
+	// negationExceptions follow a cue that is not a negation ("not only", "no less than", "не менее")
+	negationExceptions = map[string]bool{
+		"only": true, "just": true, "less": true, "more": true, "fewer": true, "later": true, "earlier": true,
+		"только": true, "менее": true, "меньше": true, "более": true, "больше": true, "позднее": true,
+	}
+	// requirementNegations follow "not"/"не" after a skill ("Java is not required", "PHP не требуется")
+	requirementNegations = map[string]bool{
+		"required": true, "needed": true, "necessary": true, "mandatory": true, "essential": true,
+		"требуется": true, "требуются": true, "нужен": true, "нужна": true, "нужно": true, "нужны": true,
+		"обязателен": true, "обязательна": true, "обязательно": true, "обязательны": true,
+	}
+	// contrastWords end the scope of a negation ("no Java, but strong Go")
+	contrastWords = map[string]bool{
+		"but": true, "however": true, "although": true, "whereas": true, "instead": true,
+		"но": true, "однако": true, "зато": true,
+	}
+	// listConjunctions join skills into a list that shares one negation
+	listConjunctions = map[string]bool{"or": true, "and": true, "nor": true, "или": true, "и": true, "ни": true}
+	// requirementCues mark a sentence as stating a skill or requirement rather than a passing mention
+	requirementCues = map[string]bool{
+		"experience": true, "experienced": true, "proficient": true, "proficiency": true, "skilled": true,
+		"knowledge": true, "expertise": true, "expert": true, "familiarity": true, "worked": true,
+		"built": true, "developed": true, "implemented": true, "required": true, "requirement": true,
+		"requirements": true, "must": true, "strong": true, "solid": true, "hands-on": true,
+		"опыт": true, "опыта": true, "знание": true, "знания": true, "владение": true, "требуется": true,
+		"требования": true, "обязательно": true, "уверенное": true, "навыки": true, "разрабатывал": true,
+	}
+)
+
+// skillMention is one occurrence of a skill with its sentence context
+type skillMention struct {
+	Negated     bool
+	Requirement bool
+	Version     string
+	Proficiency string
+}
+
+// findSkillMentions finds every mention of a dictionary skill and classifies it as negated
+// and/or in a requirement context, with any stated version and proficiency, sentence by sentence. Mentions of ambiguous entries that
+// read as ordinary words ("let's go", "spring semester") are dropped.
+func findSkillMentions(content string, isSkill func(string) bool) map[string][]skillMention {
+	mentions := make(map[string][]skillMention)
+
+	for _, sentence := range sentencePattern.Split(content, -1) {
+		spans := wordSpans(sentence)
+		original := make([]string, len(spans))
+		words := make([]string, len(spans))
+		skills := make([]bool, len(spans))
+		requirement := false
+		for i, span := range spans {
+			original[i] = sentence[span[0]:span[1]]
+			words[i] = strings.ToLower(original[i])
+			alias, isAlias := generationAliases[words[i]]
+			skills[i] = isSkill(words[i]) || isAlias && isSkill(alias.Skill)
+			if requirementCues[words[i]] {
+				requirement = true
+			}
+		}
+
+		cues := findProficiencyCues(words, isSkill)
+		prev, prevNegated, prevProficiency := -1, false, ""
+		for i, word := range words {
+			if !skills[i] {
+				continue
+			}
+			if rule, ambiguous := ambiguousSkills[word]; ambiguous && !acceptAmbiguousMention(rule, words, original, skills, i) {
+				continue
+			}
+			// "no experience with java, kotlin or scala" negates the whole list
+			negated := prevNegated && coordinated(words, prev, i) ||
+				negatedBefore(words, skills, i) || negatedAfter(words, i)
+
+			name, version := word, ""
+			if alias, ok := generationAliases[word]; ok {
+				name, version = alias.Skill, alias.Version
+			} else {
+				next := ""
+				if i+1 < len(words) {
+					next = words[i+1]
+				}
+				version = versionAfter(word, sentence[spans[i][1]:], next)
+			}
+			// "golang" and "k8s" count as mentions of go and kubernetes, for scoring and screening alike
+			if canonical, ok := skillAliases[name]; ok {
+				name = canonical
+			}
+
+			// "strong Go and Python" states one level for the whole list
+			proficiency := proficiencyNear(words, cues, i)
+			if proficiency == "" && coordinated(words, prev, i) {
+				proficiency = prevProficiency
+			}
+
+			mentions[name] = append(mentions[name], skillMention{
+				Negated:     negated,
+				Requirement: requirement,
+				Version:     version,
+				Proficiency: proficiency,
+			})
+			prev, prevNegated, prevProficiency = i, negated, proficiency
+		}
+	}
+
+	return mentions
+}
+
+// negatedBefore reports whether a negation cue precedes words[i] in the same clause.
+// skills marks which words are skills, to attach "X is not required" to X rather than what follows.
+func negatedBefore(words []string, skills []bool, i int) bool {
+	for k := i - 1; k >= 0 && k >= i-negationWindow; k-- {
+		if contrastWords[words[k]] {
+			return false
+		}
+		if !negationCues[words[k]] || k+1 >= len(words) {
+			continue
+		}
+		next := words[k+1]
+		// "Java is not required, Go is" negates Java, not the skills after it;
+		// "не требуется знание PHP" has no skill before the cue and negates PHP
+		if negationExceptions[next] || requirementNegations[next] && skillBefore(words, skills, k) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// coordinated reports whether words[j] and words[i] are items of one list ("java, kotlin or scala")
+func coordinated(words []string, j, i int) bool {
+	if j < 0 {
+		return false
+	}
+	for k := j + 1; k < i; k++ {
+		if !listConjunctions[words[k]] {
+			return false
+		}
+	}
+	return true
+}
+
+// skillBefore reports whether a skill precedes words[k] in the same clause
+func skillBefore(words []string, skills []bool, k int) bool {
+	for j := k - 1; j >= 0 && !contrastWords[words[j]]; j-- {
+		if skills[j] {
+			return true
+		}
+	}
+	return false
+}
+
+// negatedAfter reports whether words[i] is followed by "not required" or "не требуется"
+func negatedAfter(words []string, i int) bool {
+	for k := i + 1; k < len(words)-1 && k <= i+postNegationWindow; k++ {
+		if contrastWords[words[k]] {
+			return false
+		}
+		if (words[k] == "not" || words[k] == "isn't" || words[k] == "aren't" || words[k] == "не") && requirementNegations[words[k+1]] {
+			return true
+		}
+	}
+	return false
+}
+
+// mentionsNegated reports whether a skill is mentioned only in negated contexts
+func mentionsNegated(mentions []skillMention) bool {
+	if len(mentions) == 0 {
+		return false
+	}
+	for _, m := range mentions {
+		if !m.Negated {
+			return false
+		}
+	}
+	return true
+}
+
+// contextConfidence scores a skill from its mentions: repeated affirmative mentions and
+// mentions in a requirement context raise confidence; negated mentions add nothing
+func contextConfidence(mentions []skillMention) float64 {
+	confidence := 0.5
+	requirement := false
+	for _, m := range mentions {
+		if m.Negated {
+			continue
+		}
+		confidence += 0.1
+		requirement = requirement || m.Requirement
+	}
+	if requirement {
+		confidence += 0.2
+	}
+	if confidence > 1.0 {
+		confidence = 1.0
+	}
+	return confidence
+}
+
+// mentionsVersion returns the newest version among affirmative mentions ("" if none names one)
+func mentionsVersion(mentions []skillMention) string {
+	version := ""
+	for _, m := range mentions {
+		if m.Negated || m.Version == "" {
+			continue
+		}
+		if version == "" || newerVersion(m.Version, version) {
+			version = m.Version
+		}
+	}
+	return version
+}
+
+// withoutNegated drops skills that are only mentioned as not needed
+func withoutNegated(skills []Skill) []Skill {
+	result := make([]Skill, 0, len(skills))
+	for _, skill := range skills {
+		if !skill.Negated {
+			result = append(result, skill)
+		}
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExtractSkills_Negation(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	tests := []struct {
+		content string
+		skill   string
+		negated bool
+	}{
+		{"No experience with Java", "java", true},
+		{"Java is not required", "java", true},
+		{"не требуется знание PHP", "php", true},
+		{"Знание PHP не требуется", "php", true},
+		{"Без опыта Java", "java", true},
+		{"no experience with java, kotlin or scala", "scala", true},
+		{"No Java, but strong Python", "python", false},
+		{"Java is not required, Python is", "python", false},
+		{"Not only Python but also Go", "python", false},
+		{"не менее 3 лет опыта с Python", "python", false},
+		{"No remote work.\nPython developer", "python", false},
+		{"Never used Java. Java at my last job was great", "java", false}, // One affirmative mention is enough
+		{"Python developer", "python", false},
+	}
+
+	for _, tt := range tests {
+		var found *Skill
+		for _, skill := range ExtractSkills(ctx, tt.content, sd) {
+			if skill.Name == tt.skill {
+				found = &skill
+				break
+			}
+		}
+		if found == nil {
+			t.Errorf("Skill %q not found in %q", tt.skill, tt.content)
+			continue
+		}
+		if found.Negated != tt.negated {
+			t.Errorf("For %q, expected %s negated=%v", tt.content, tt.skill, tt.negated)
+		}
+	}
+}
+
+func TestExtractSkills_ContextConfidence(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	confidence := func(content string) float64 {
+		for _, skill := range ExtractSkills(ctx, content, sd) {
+			if skill.Name == "python" {
+				return skill.Confidence
+			}
+		}
+		t.Fatalf("python not found in %q", content)
+		return 0
+	}
+
+	plain := confidence("Python scripts in the repo")
+	required := confidence("Strong knowledge of Python")
+	russian := confidence("Уверенное знание Python")
+	negated := confidence("No Python experience")
+
+	if required <= plain {
+		t.Errorf("Requirement context should raise confidence: plain %f, required %f", plain, required)
+	}
+	if russian != required {
+		t.Errorf("Russian requirement context should score like English: %f vs %f", russian, required)
+	}
+	if negated >= plain {
+		t.Errorf("Negated mention should not raise confidence: plain %f, negated %f", plain, negated)
+	}
+}
+
+func TestMatchSkills_NegatedCVSkill(t *testing.T) {
+	cvSkills := []Skill{{Name: "java", Negated: true}, {Name: "python"}}
+	jdSkills := []Skill{{Name: "java"}, {Name: "python"}}
+
+	matches, missing, _ := MatchSkills(cvSkills, jdSkills)
+	if len(matches) != 1 || matches[0].Name != "python" {
+		t.Errorf("Expected only python to match, got %v", matches)
+	}
+	if len(missing) != 1 || missing[0].Name != "java" {
+		t.Errorf("Expected negated java to be missing, got %v", missing)
+	}
+}
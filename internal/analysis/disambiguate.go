@@ -0,0 +1,125 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ambiguityRule decides whether a dictionary entry that is also an ordinary word
+// ("go", "spring", "express") is meant as a technology in a given sentence
+type ambiguityRule struct {
+	// Strict entries (single letters) need both a capital letter and technical context
+	Strict bool
+	// Context lists words that mark the sentence as being about this technology
+	Context []string
+	// Before and After reject mentions next to these words ("let's go", "spring semester")
+	Before []string
+	After  []string
+}
+
+// ambiguousSkills holds disambiguation rules for dictionary entries that collide with ordinary words
+var ambiguousSkills = map[string]ambiguityRule{
+	"go": {
+		Context: []string{"golang", "goroutines", "goroutine", "grpc", "gin", "backend", "microservices", "concurrency"},
+		Before:  []string{"let's", "lets", "will", "would", "could", "should", "can", "ready", "i", "we", "you", "they"},
+		After:   []string{"ahead", "back", "through", "beyond", "above", "live", "home", "out", "away", "further", "along", "into", "the"},
+	},
+	"r": {
+		Strict:  true,
+		Context: []string{"statistics", "statistical", "ggplot2", "rstudio", "cran", "tidyverse", "shiny", "dplyr", "sas", "spss", "matlab"},
+		Before:  []string{"section", "part", "grade", "class", "type", "plan", "appendix"},
+	},
+	"c": {
+		Strict:  true,
+		Context: []string{"embedded", "firmware", "kernel", "pointers", "microcontroller", "microcontrollers", "posix", "assembly", "rtos"},
+		Before:  []string{"section", "part", "grade", "class", "type", "plan", "vitamin", "appendix", "level"},
+	},
+	"swift": {
+		Context: []string{"ios", "xcode", "swiftui", "uikit", "cocoa", "macos", "apple", "objective-c"},
+		After:   []string{"delivery", "response", "onboarding", "action", "turnaround", "resolution", "progress", "execution", "feedback"},
+	},
+	"express": {
+		Context: []string{"node", "nodejs", "javascript", "typescript", "middleware", "api", "apis", "rest"},
+		Before:  []string{"to", "i", "we", "american"},
+		After:   []string{"delivery", "shipping", "mail", "train", "entry", "checkout", "lane", "interest", "consent", "written"},
+	},
+	"spring": {
+		Context: []string{"java", "boot", "mvc", "hibernate", "jpa", "kotlin", "microservices"},
+		After:   []string{"semester", "term", "break", "season", "cleaning", "festival"},
+	},
+	"rust": {
+		Context: []string{"cargo", "crates", "tokio", "wasm", "webassembly", "systems", "embedded"},
+		Before:  []string{"remove", "prevent", "prevents"},
+		After:   []string{"belt", "proof", "removal", "colored", "coloured"},
+	},
+	"ember": {
+		Context: []string{"javascript", "js", "handlebars", "frontend", "spa"},
+		After:   []string{"glow", "glowing"},
+	},
+}
+
+// acceptAmbiguousMention decides whether words[i] is the technology rather than the ordinary word.
+// original holds the words with their original case and skills marks dictionary skills in the sentence.
+func acceptAmbiguousMention(rule ambiguityRule, words, original []string, skills []bool, i int) bool {
+	if i > 0 && containsString(rule.Before, words[i-1]) {
+		return false
+	}
+	// A following year is a season or date ("Spring 2019"), not the technology
+	if i+1 < len(words) && (containsString(rule.After, words[i+1]) || yearPattern.MatchString(words[i+1])) {
+		return false
+	}
+
+	r, _ := utf8.DecodeRuneInString(original[i])
+	capitalized := unicode.IsUpper(r)
+	if rule.Strict && !capitalized {
+		return false
+	}
+
+	// A capital letter mid-sentence ("experience in Go") is a strong signal on its own;
+	// at the start of a sentence or bullet it is just grammar
+	if capitalized && !rule.Strict && !sentenceStart(words, i) {
+		return true
+	}
+
+	return technicalContext(rule, words, skills, i)
+}
+
+// sentenceStart reports whether no word with letters precedes words[i] ("- Go is ..." starts at "Go")
+func sentenceStart(words []string, i int) bool {
+	for k := 0; k < i; k++ {
+		for _, r := range words[k] {
+			if unicode.IsLetter(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// technicalContext reports whether the sentence around words[i] is about technology: it names
+// another unambiguous skill or one of the rule's context words; non-strict rules also accept
+// requirement cues ("experience with go") and experience phrases ("go (3 years)")
+func technicalContext(rule ambiguityRule, words []string, skills []bool, i int) bool {
+	for k, word := range words {
+		if k == i {
+			continue
+		}
+		if skills[k] {
+			if _, ambiguous := ambiguousSkills[word]; !ambiguous {
+				return true
+			}
+		}
+		if containsString(rule.Context, word) {
+			return true
+		}
+		if !rule.Strict && requirementCues[word] {
+			return true
+		}
+	}
+	if rule.Strict {
+		return false
+	}
+	_, found := ParseExperience(strings.Join(words, " "))
+	return found
+}
@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+func TestExtractSkills_AmbiguousSkills(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	tests := []struct {
+		content string
+		skill   string
+		found   bool
+	}{
+		{"Experience in Go and Kubernetes", "go", true},
+		{"Backend services written in Go", "go", true},
+		{"go, python, docker", "go", true},
+		{"5 years of go experience", "go", true},
+		{"Let's go!", "go", false},
+		{"Always ready to go the extra mile", "go", false},
+		{"Go ahead with the plan", "go", false},
+		{"Swift delivery of features", "swift", false},
+		{"iOS apps in Swift and SwiftUI", "swift", true},
+		{"Express shipping for orders", "express", false},
+		{"REST APIs with Node and Express", "express", true},
+		{"Started in Spring 2019", "spring", false},
+		{"Microservices with Spring Boot", "spring", true},
+		{"Rust belt manufacturing", "rust", false},
+		{"Systems programming in Rust", "rust", true},
+		{"Statistical analysis in R", "r", true},
+		{"Skills: Python, R, SQL", "r", true},
+		{"Section R of the report", "r", false},
+		{"Firmware in C for microcontrollers", "c", true},
+		{"Received grade C in the course", "c", false},
+		{"vitamin c", "c", false},
+	}
+
+	for _, tt := range tests {
+		found := false
+		for _, skill := range ExtractSkills(ctx, tt.content, sd) {
+			if skill.Name == tt.skill {
+				found = true
+			}
+		}
+		if found != tt.found {
+			t.Errorf("For %q, expected %s found=%v", tt.content, tt.skill, tt.found)
+		}
+	}
+}
+
+func TestExtractSkills_AmbiguousTestdata(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	tests := []struct {
+		file    string
+		present []string
+		absent  []string
+	}{
+		{"cv.md", []string{"go", "kubernetes", "postgresql"}, nil},
+		{"job.md", []string{"go", "kubernetes"}, nil},
+		{"job_ru.md", []string{"go", "kubernetes"}, nil},
+		{"cv_ambiguous.md", []string{"python", "scala", "spark", "r", "kafka"}, []string{"go", "swift", "express", "rust", "spring", "c"}},
+	}
+
+	for _, tt := range tests {
+		content, err := os.ReadFile(filepath.Join("..", "..", "testdata", tt.file))
+		if err != nil {
+			t.Fatalf("failed to read testdata %s: %v", tt.file, err)
+		}
+
+		names := make(map[string]bool)
+		for _, skill := range ExtractSkills(ctx, string(content), sd) {
+			names[skill.Name] = true
+		}
+		for _, skill := range tt.present {
+			if !names[skill] {
+				t.Errorf("%s: expected %q to be extracted", tt.file, skill)
+			}
+		}
+		for _, skill := range tt.absent {
+			if names[skill] {
+				t.Errorf("%s: %q is an ordinary word here and should not be extracted", tt.file, skill)
+			}
+		}
+	}
+}
+
+func TestMarkSkillRequirements_StrictSkills(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+	jd := parse.ParseJD(`# Embedded Data Engineer
+
+## Requirements
+- C for embedded firmware
+- R for statistics
+
+## Nice to have
+- Docker
+`)
+
+	requirements := make(map[string]string)
+	for _, skill := range markSkillRequirements(ctx, withoutNegated(ExtractSkills(ctx, jd.ScoringText(), sd)), jd, sd) {
+		requirements[skill.Name] = skill.Requirement
+	}
+
+	for skill, want := range map[string]string{"c": RequirementRequired, "r": RequirementRequired, "docker": RequirementPreferred} {
+		if got := requirements[skill]; got != want {
+			t.Errorf("Expected %s to be %q, got %q (skills %v)", skill, want, got, requirements)
+		}
+	}
+}
@@ -2,17 +2,27 @@ package analysis
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/mapping"
 	"github.com/blevesearch/bleve/v2/search/query"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
 )
 
 var logger = slog.Default()
 
+// EngineVersion identifies the extraction and scoring logic. Bump it whenever a change alters
+// analysis results, so cached results from the previous logic are no longer used.
+const EngineVersion = "3"
+
 // TermScore represents a term with its BM25 score
 type TermScore struct {
 	Term  string  `json:"term"`
@@ -21,28 +31,106 @@ type TermScore struct {
 
 // AnalysisResult contains structured analysis output
 type AnalysisResult struct {
-	MatchPercentage  int             `json:"match_percentage"`
-	WeightedScore    int             `json:"weighted_score"`
-	SkillCoverage    float64         `json:"skill_coverage"`
-	ExperienceMatch  float64         `json:"experience_match"`
-	TopSkills        []string        `json:"top_skills"`
-	MissingSkills    []string        `json:"missing_skills"`
-	PresentSkills    []string        `json:"present_skills"`
-	CommonTerms      []TermScore     `json:"common_terms"`
-	ScoringBreakdown *ScoreBreakdown `json:"scoring_breakdown"`
+	MatchPercentage      int                 `json:"match_percentage"`
+	WeightedScore        int                 `json:"weighted_score"`
+	SkillCoverage        float64             `json:"skill_coverage"`
+	ExperienceMatch      float64             `json:"experience_match"`
+	ProficiencyAlignment float64             `json:"proficiency_alignment"`
+	TopSkills            []string            `json:"top_skills"`
+	MissingSkills        []string            `json:"missing_skills"`
+	PresentSkills        []string            `json:"present_skills"`
+	SkillRecency         []SkillRecency      `json:"skill_recency"`
+	NegatedSkills        []string            `json:"negated_skills,omitempty"`
+	SkillVersions        []SkillVersionMatch `json:"skill_versions,omitempty"`
+	LanguageRequirements []LanguageMatch     `json:"language_requirements,omitempty"`
+	LanguagesMet         bool                `json:"languages_met"`
+	CVConstraints        *WorkConstraints    `json:"cv_constraints,omitempty"`
+	JDConstraints        *WorkConstraints    `json:"jd_constraints,omitempty"`
+	Constraints          []ConstraintCheck   `json:"constraints,omitempty"`
+	ConstraintsMet       bool                `json:"constraints_met"`
+	Salary               *SalaryMatch        `json:"salary,omitempty"`
+	Rules                []RuleResult        `json:"rules,omitempty"`
+	ScreeningStatus      string              `json:"screening_status,omitempty"` // Combined rule outcome, "" without rules
+	CommonTerms          []TermScore         `json:"common_terms"`
+	ScoringBreakdown     *ScoreBreakdown     `json:"scoring_breakdown"`
+	Weights              ScoringWeights      `json:"weights"`               // Normalized weights the breakdown was combined with
+	Explanation          *ScoreExplanation   `json:"explanation,omitempty"` // Set by Explain
 }
 
 // AnalysisEngine uses bleve BM25 for CV/JD matching
 type AnalysisEngine struct {
-	indexMapping mapping.IndexMapping
+	indexMapping    mapping.IndexMapping
+	recencyHalfLife float64
+	salary          SalaryConfig
+	weights         ScoringWeights
+	now             func() time.Time
 }
 
 // NewAnalysisEngine creates a new analysis engine with bleve BM25 configuration
 func NewAnalysisEngine() *AnalysisEngine {
 	indexMapping := bleve.NewIndexMapping()
 	return &AnalysisEngine{
-		indexMapping: indexMapping,
+		indexMapping:    indexMapping,
+		recencyHalfLife: DefaultRecencyHalfLife,
+		salary:          DefaultSalaryConfig(),
+		weights:         NewDefaultWeights(),
+		now:             time.Now,
+	}
+}
+
+// WithRecencyHalfLife sets how many years it takes an unused skill to lose half its weight (<= 0 disables)
+func (e *AnalysisEngine) WithRecencyHalfLife(years float64) *AnalysisEngine {
+	e.recencyHalfLife = years
+	return e
+}
+
+// WithSalaryConfig sets the base currency, exchange rates and net-to-gross ratio used to compare salaries
+func (e *AnalysisEngine) WithSalaryConfig(cfg SalaryConfig) *AnalysisEngine {
+	e.salary = cfg
+	return e
+}
+
+// WithWeights sets the scoring weights the engine combines metrics with
+func (e *AnalysisEngine) WithWeights(weights ScoringWeights) *AnalysisEngine {
+	e.weights = weights
+	return e
+}
+
+// Clone returns a copy of the engine, so per-request settings such as weights can be changed
+// without affecting other callers
+func (e *AnalysisEngine) Clone() *AnalysisEngine {
+	clone := *e
+	return &clone
+}
+
+// Weights returns the scoring weights the engine combines metrics with
+func (e *AnalysisEngine) Weights() ScoringWeights {
+	return e.weights
+}
+
+// RecencyHalfLife returns the years after which an unused CV skill counts half (<= 0 disabled)
+func (e *AnalysisEngine) RecencyHalfLife() float64 {
+	return e.recencyHalfLife
+}
+
+// Fingerprint identifies everything besides the documents that an analysis result depends on:
+// the engine version, skills dictionary, scoring weights, settings and the current year (for recency)
+func (e *AnalysisEngine) Fingerprint() string {
+	parts := fmt.Sprintf("%s|%s|%v|%v|%s|%v|%v|%d",
+		EngineVersion, NewSkillsDictionary().Checksum(), e.weights,
+		e.recencyHalfLife, e.salary.BaseCurrency, e.salary.NetRatio, sortedRates(e.salary.Rates), e.now().Year())
+	hash := sha256.Sum256([]byte(parts))
+	return hex.EncodeToString(hash[:8])
+}
+
+// sortedRates formats exchange rates in a stable order
+func sortedRates(rates map[string]float64) []string {
+	formatted := make([]string, 0, len(rates))
+	for currency, rate := range rates {
+		formatted = append(formatted, fmt.Sprintf("%s=%v", currency, rate))
 	}
+	sort.Strings(formatted)
+	return formatted
 }
 
 // preprocessText normalizes text for analysis
@@ -57,14 +145,37 @@ func preprocessText(text string) string {
 
 // Analyze performs BM25-based analysis between CV and JD with skill extraction
 func (e *AnalysisEngine) Analyze(ctx context.Context, cvContent, jdContent string) (*AnalysisResult, error) {
+	return e.AnalyzeWithRules(ctx, cvContent, jdContent, nil)
+}
+
+// AnalyzeWithRules performs Analyze and also evaluates the JD's screening rules against the CV
+func (e *AnalysisEngine) AnalyzeWithRules(ctx context.Context, cvContent, jdContent string, rules []Rule) (*AnalysisResult, error) {
+	return e.analyze(ctx, cvContent, jdContent, rules, analysisOptions{})
+}
+
+// analysisOptions adjust an analysis beyond what the CV text says, for simulations
+type analysisOptions struct {
+	// totalYears caps the years credited for any CV skill; skills without stated years stay at 0 (0: off)
+	totalYears int
+}
+
+// analyze runs the full analysis pipeline
+func (e *AnalysisEngine) analyze(ctx context.Context, cvContent, jdContent string, rules []Rule, opts analysisOptions) (*AnalysisResult, error) {
 	logger.DebugContext(ctx, "starting BM25 analysis with skill extraction",
 		"cv_length", len(cvContent),
 		"jd_length", len(jdContent),
 	)
 
+	// Score against the parsed JD so benefits and company boilerplate don't dilute matching
+	jd := parse.ParseJD(jdContent)
+	jdText := jd.ScoringText()
+	if strings.TrimSpace(jdText) == "" {
+		jdText = jdContent
+	}
+	jdClean := preprocessText(jdText)
+
 	// Preprocess content
 	cvClean := preprocessText(cvContent)
-	jdClean := preprocessText(jdContent)
 
 	if cvClean == "" || jdClean == "" {
 		return nil, fmt.Errorf("both CV and JD content must not be empty")
@@ -94,10 +205,38 @@ func (e *AnalysisEngine) Analyze(ctx context.Context, cvContent, jdContent strin
 	cvTerms := extractTermFrequenciesFromIndex(bleveIndex, "cv")
 	jdTerms := extractTermFrequenciesFromIndex(bleveIndex, "jd")
 
-	// Extract skills using dictionary-based matching
+	// Extract skills using dictionary-based matching. Skills are extracted from the
+	// unflattened text so negation and experience phrases stay within their sentence.
 	skillsDict := NewSkillsDictionary()
-	cvSkills := ExtractSkills(ctx, cvClean, skillsDict)
-	jdSkills := ExtractSkills(ctx, jdClean, skillsDict)
+	cvSkills := ExtractSkills(ctx, cvContent, skillsDict)
+	cv := parse.ParseCV(cvContent)
+	cvSkills = ApplySkillRecency(cvSkills, cv, e.now().Year(), e.recencyHalfLife)
+	if opts.totalYears > 0 {
+		for i := range cvSkills {
+			if cvSkills[i].Experience > opts.totalYears {
+				cvSkills[i].Experience = opts.totalYears
+			}
+		}
+	}
+	// "Java is not required" is not a JD requirement
+	jdSkills := withoutNegated(ExtractSkills(ctx, jdText, skillsDict))
+	if jd.IsStructured() {
+		jdSkills = markSkillRequirements(ctx, jdSkills, jd, skillsDict)
+	}
+
+	// Spoken languages are hard requirements, checked apart from technical skill coverage
+	jdLanguages := ExtractLanguageRequirements(jdText)
+	if jd.IsStructured() {
+		jdLanguages = markLanguageRequirements(jdLanguages, jd)
+	}
+	cvLanguages := extractCVLanguages(cvContent, cv)
+	languageMatches := MatchLanguages(cvLanguages, jdLanguages)
+
+	// Location, remote policy and visa constraints are knockout factors the BM25 score hides
+	cvConstraints := ExtractCVConstraints(cvContent, cv)
+	jdConstraints := ExtractJDConstraints(jdContent, jd)
+	constraintChecks := CheckConstraints(cvConstraints, jdConstraints)
+	salaryMatch := e.matchSalary(cvContent, jdContent, jd)
 
 	// Calculate match metrics using BM25 scores
 	result := e.calculateMatchMetrics(cvTerms, jdTerms)
@@ -105,6 +244,7 @@ func (e *AnalysisEngine) Analyze(ctx context.Context, cvContent, jdContent strin
 	// Calculate skill-based metrics
 	skillCoverage := CalculateSkillCoverage(cvSkills, jdSkills)
 	experienceMatch := CalculateExperienceMatch(cvSkills, jdSkills)
+	proficiencyAlignment := CalculateProficiencyAlignment(cvSkills, jdSkills)
 
 	// Calculate term similarity from BM25 (normalized 0-1)
 	termSimilarity := 0.0
@@ -115,41 +255,104 @@ func (e *AnalysisEngine) Analyze(ctx context.Context, cvContent, jdContent strin
 	// Calculate overall match from BM25 (normalized 0-1)
 	overallMatch := float64(result.MatchPercentage) / 100.0
 
-	// Calculate weighted score using default weights
+	// Calculate weighted score using the engine weights
 	weightedScore, breakdown := CalculateWeightedScore(
 		skillCoverage,
 		experienceMatch,
 		termSimilarity,
 		overallMatch,
-		NewDefaultWeights(),
+		proficiencyAlignment,
+		e.weights,
 	)
 
 	// Update result with skill-based metrics
 	result.WeightedScore = weightedScore
 	result.ExperienceMatch = experienceMatch
 	result.SkillCoverage = skillCoverage
+	result.ProficiencyAlignment = proficiencyAlignment
 	result.ScoringBreakdown = breakdown
+	result.Weights = e.weights.Normalize()
 
 	// Add present skills (skills that CV has that JD needs)
 	presentSkills := make([]string, 0, len(cvSkills))
+	skillRecency := make([]SkillRecency, 0, len(cvSkills))
+	var negatedSkills []string
 	for _, skill := range cvSkills {
+		// Flag "no experience with X" instead of presenting X as a skill
+		if skill.Negated {
+			negatedSkills = append(negatedSkills, skill.Name)
+			continue
+		}
 		presentSkills = append(presentSkills, skill.Name)
+		skillRecency = append(skillRecency, SkillRecency{Skill: skill.Name, LastUsed: skill.LastUsed, Recency: skill.Recency})
 	}
 	result.PresentSkills = presentSkills
+	result.SkillRecency = skillRecency
+	result.NegatedSkills = negatedSkills
+	result.SkillVersions = versionMatches(cvSkills, jdSkills)
+	result.LanguageRequirements = languageMatches
+	result.LanguagesMet = LanguagesMet(languageMatches)
+	result.CVConstraints = &cvConstraints
+	result.JDConstraints = &jdConstraints
+	result.Constraints = constraintChecks
+	result.ConstraintsMet = ConstraintsMet(constraintChecks)
+	result.Salary = salaryMatch
+
+	// Operator-defined knockout rules
+	if len(rules) > 0 {
+		candidate := BuildCandidateProfile(cvContent, cv, cvSkills, cvLanguages, cvConstraints, e.now().Year())
+		result.Rules = EvaluateRules(rules, candidate, skillsDict)
+		result.ScreeningStatus = ScreeningStatus(result.Rules)
+	}
 
 	logger.DebugContext(ctx, "BM25 analysis complete",
 		"match_percentage", result.MatchPercentage,
 		"weighted_score", result.WeightedScore,
 		"skill_coverage", result.SkillCoverage,
 		"experience_match", result.ExperienceMatch,
+		"proficiency_alignment", result.ProficiencyAlignment,
 		"top_skills", len(result.TopSkills),
 		"missing_skills", len(result.MissingSkills),
 		"present_skills", len(result.PresentSkills),
+		"languages_met", result.LanguagesMet,
+		"constraints_met", result.ConstraintsMet,
+		"screening_status", result.ScreeningStatus,
 	)
 
 	return result, nil
 }
 
+// matchSalary compares the CV salary expectation with the JD band, or returns nil when neither states one
+func (e *AnalysisEngine) matchSalary(cvContent, jdContent string, jd *parse.JobDescription) *SalaryMatch {
+	var cvSalary, jdSalary *SalaryRange
+	if r, ok := ExtractCVSalary(cvContent); ok {
+		cvSalary = &r
+	}
+	if r, ok := ExtractJDSalary(jdContent, jd); ok {
+		jdSalary = &r
+	}
+	return MatchSalary(cvSalary, jdSalary, e.salary)
+}
+
+// markSkillRequirements tags JD skills as required or preferred. Skills that only
+// appear in the preferred (nice-to-have) qualifications are marked preferred.
+func markSkillRequirements(ctx context.Context, jdSkills []Skill, jd *parse.JobDescription, dict *SkillsDictionary) []Skill {
+	required := make(map[string]bool)
+	// The raw text keeps the capital letters strict entries (C, R) need
+	for _, skill := range ExtractSkills(ctx, jd.CoreText(), dict) {
+		required[skill.Name] = true
+	}
+
+	for i := range jdSkills {
+		if required[jdSkills[i].Name] {
+			jdSkills[i].Requirement = RequirementRequired
+		} else {
+			jdSkills[i].Requirement = RequirementPreferred
+		}
+	}
+	return jdSkills
+}
+
 // extractTermFrequenciesFromIndex extracts term frequencies for a specific document
 // using bleve's BM25 scoring. This uses search queries to get BM25-weighted term scores.
 func extractTermFrequenciesFromIndex(bleveIndex bleve.Index, docID string) map[string]float64 {
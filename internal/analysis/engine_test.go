@@ -3,6 +3,7 @@ package analysis
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestNewAnalysisEngine(t *testing.T) {
@@ -364,6 +365,38 @@ func TestEngine_Analyze_MatchPercentageBoundaries(t *testing.T) {
 	}
 }
 
+func TestEngine_Analyze_StructuredJD(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	jd := `# Backend Engineer
+
+## Requirements
+- python
+- docker
+
+## Nice to have
+- kubernetes
+
+## Benefits
+- java training budget
+`
+
+	// Benefits are not part of the scoring input
+	result, err := engine.Analyze(ctx, "python docker java", jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if result.SkillCoverage != 0.8 {
+		t.Errorf("Expected skill coverage 0.8 with missing preferred skill, got %f", result.SkillCoverage)
+	}
+	for _, skill := range result.MissingSkills {
+		if skill == "java" {
+			t.Errorf("Benefits leaked into missing skills: %v", result.MissingSkills)
+		}
+	}
+}
+
 func TestPreprocessText(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -385,3 +418,222 @@ func TestPreprocessText(t *testing.T) {
 		}
 	}
 }
+
+func TestEngine_Analyze_SkillRecency(t *testing.T) {
+	engine := NewAnalysisEngine()
+	engine.now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	result, err := engine.Analyze(ctx, recencyCV, "Go and PHP developer")
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	lastUsed := make(map[string]int)
+	for _, sr := range result.SkillRecency {
+		lastUsed[sr.Skill] = sr.LastUsed
+	}
+	if lastUsed["go"] != 2025 || lastUsed["php"] != 2012 {
+		t.Errorf("Expected go last used 2025 and php 2012, got %v", lastUsed)
+	}
+	if result.SkillCoverage >= 1.0 {
+		t.Errorf("Expected stale php to reduce skill coverage, got %f", result.SkillCoverage)
+	}
+
+	// Disabling the half-life restores full coverage
+	result, err = engine.WithRecencyHalfLife(0).Analyze(ctx, recencyCV, "Go and PHP developer")
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if result.SkillCoverage != 1.0 {
+		t.Errorf("Expected full skill coverage without recency, got %f", result.SkillCoverage)
+	}
+}
+
+func TestEngine_Analyze_Negation(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	cv := "Python developer.\nNo experience with Java."
+	jd := "We need a Python developer. Kubernetes is not required."
+
+	result, err := engine.Analyze(ctx, cv, jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	// Negated JD skills are not requirements, so python alone gives full coverage
+	if result.SkillCoverage != 1.0 {
+		t.Errorf("Expected skill coverage 1.0, got %f", result.SkillCoverage)
+	}
+	if len(result.NegatedSkills) != 1 || result.NegatedSkills[0] != "java" {
+		t.Errorf("Expected java flagged as negated, got %v", result.NegatedSkills)
+	}
+	for _, skill := range result.PresentSkills {
+		if skill == "java" {
+			t.Errorf("Negated java listed as present: %v", result.PresentSkills)
+		}
+	}
+}
+
+func TestEngine_Analyze_SkillVersions(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	result, err := engine.Analyze(ctx, "Maintained Java 8 services and Python tooling", "Backend engineer with Java 17 and Python")
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	if len(result.SkillVersions) != 1 {
+		t.Fatalf("Expected one version requirement, got %v", result.SkillVersions)
+	}
+	sv := result.SkillVersions[0]
+	if sv.Skill != "java" || sv.Required != "17" || sv.Claimed != "8" || sv.Match != VersionLegacy {
+		t.Errorf("Unexpected version match: %+v", sv)
+	}
+	if result.SkillCoverage >= 1.0 {
+		t.Errorf("Expected legacy Java to reduce skill coverage, got %f", result.SkillCoverage)
+	}
+}
+
+func TestEngine_Analyze_ProficiencyAlignment(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	jd := "Backend engineer with deep knowledge of Python and Docker"
+	expert, err := engine.Analyze(ctx, "Expert in Python and Docker", jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	basic, err := engine.Analyze(ctx, "Basic knowledge of Python and Docker", jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	if expert.ProficiencyAlignment != 1.0 {
+		t.Errorf("Expected full proficiency alignment, got %f", expert.ProficiencyAlignment)
+	}
+	if basic.ProficiencyAlignment >= expert.ProficiencyAlignment {
+		t.Errorf("Expected basic claims to align worse (%f) than expert claims (%f)", basic.ProficiencyAlignment, expert.ProficiencyAlignment)
+	}
+	if expert.ScoringBreakdown.Proficiency != expert.ProficiencyAlignment {
+		t.Errorf("Expected breakdown to carry proficiency alignment, got %+v", expert.ScoringBreakdown)
+	}
+}
+
+func TestEngine_Analyze_LanguageRequirements(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	cv := "# Jane Doe\n\n## Skills\nGo, Docker\n\n## Languages\n- English (B1)\n- German - native\n"
+	jd := "Go engineer. English B2+ required. Fluent German."
+
+	result, err := engine.Analyze(ctx, cv, jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	if len(result.LanguageRequirements) != 2 {
+		t.Fatalf("Expected two language requirements, got %+v", result.LanguageRequirements)
+	}
+	english, german := result.LanguageRequirements[0], result.LanguageRequirements[1]
+	if english.Language != "English" || english.Pass || english.Claimed != "B1" || english.Required != "B2" {
+		t.Errorf("Expected English B1 to fail B2, got %+v", english)
+	}
+	if german.Language != "German" || !german.Pass {
+		t.Errorf("Expected native German to pass, got %+v", german)
+	}
+	if result.LanguagesMet {
+		t.Error("Expected languages_met to be false")
+	}
+	for _, skill := range result.PresentSkills {
+		if skill == "english" || skill == "german" {
+			t.Errorf("Spoken languages should not count as technical skills: %v", result.PresentSkills)
+		}
+	}
+}
+
+func TestEngine_Analyze_Constraints(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	result, err := engine.Analyze(ctx, remoteBrazilCV, onsiteBerlinJD)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	if result.ConstraintsMet {
+		t.Errorf("Expected constraints not to be met, got %+v", result.Constraints)
+	}
+	if result.CVConstraints == nil || result.JDConstraints == nil {
+		t.Fatal("Expected extracted constraints for both documents")
+	}
+	if result.JDConstraints.Location == nil || result.JDConstraints.Location.Name != "Berlin" {
+		t.Errorf("Expected JD location Berlin, got %+v", result.JDConstraints.Location)
+	}
+}
+
+func TestEngine_Analyze_Salary(t *testing.T) {
+	engine := NewAnalysisEngine()
+	ctx := context.Background()
+
+	cv := "# Jane Doe\n\nGo developer.\n\nSalary expectations: €80k per year\n"
+	jd := "# Backend Engineer\n\nWe need Go.\n\nSalary: €70,000 - €90,000 per year\n"
+
+	result, err := engine.Analyze(ctx, cv, jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	if result.Salary == nil {
+		t.Fatal("Expected a salary match")
+	}
+	if result.Salary.Status != SalaryWithin || result.Salary.Currency != "EUR" {
+		t.Errorf("Expected expectation within the EUR band, got %+v", result.Salary)
+	}
+}
+
+func TestEngine_AnalyzeWithRules(t *testing.T) {
+	engine := NewAnalysisEngine()
+	engine.now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	rules, err := ParseRules("skill Go; years >= 5; location in Serbia; degree >= bachelor")
+	if err != nil {
+		t.Fatalf("ParseRules failed: %v", err)
+	}
+	result, err := engine.AnalyzeWithRules(ctx, screeningCV, onsiteBerlinJD, rules)
+	if err != nil {
+		t.Fatalf("AnalyzeWithRules failed: %v", err)
+	}
+
+	if len(result.Rules) != 4 || result.ScreeningStatus != CheckPass {
+		t.Errorf("Expected 4 passing rules, got %s: %+v", result.ScreeningStatus, result.Rules)
+	}
+
+	result, err = engine.Analyze(ctx, screeningCV, onsiteBerlinJD)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if result.Rules != nil || result.ScreeningStatus != "" {
+		t.Errorf("Expected no rule results without rules, got %+v", result.Rules)
+	}
+}
+
+func TestEngine_Fingerprint(t *testing.T) {
+	engine := NewAnalysisEngine()
+	base := engine.Fingerprint()
+
+	if base != NewAnalysisEngine().Fingerprint() {
+		t.Error("Expected identical engines to share a fingerprint")
+	}
+	if engine.WithRecencyHalfLife(2).Fingerprint() == base {
+		t.Error("Expected the recency half-life to change the fingerprint")
+	}
+
+	other := NewAnalysisEngine().WithSalaryConfig(SalaryConfig{BaseCurrency: "EUR", Rates: map[string]float64{"USD": 0.9}})
+	if other.Fingerprint() == base {
+		t.Error("Expected salary settings to change the fingerprint")
+	}
+}
@@ -0,0 +1,337 @@
+package analysis
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EvalCandidate is a CV with a human relevance label for one JD
+type EvalCandidate struct {
+	ID     string  `json:"id,omitempty"`
+	CV     string  `json:"cv,omitempty"`      // Inline CV markdown
+	CVFile string  `json:"cv_file,omitempty"` // Or a path relative to the dataset file
+	Label  float64 `json:"score"`             // Human score or relevance grade; higher is better
+}
+
+// EvalQuery is a JD with labeled candidates
+type EvalQuery struct {
+	ID         string          `json:"id,omitempty"`
+	JD         string          `json:"jd,omitempty"`
+	JDFile     string          `json:"jd_file,omitempty"`
+	Candidates []EvalCandidate `json:"candidates"`
+}
+
+// evalLine is one dataset line: a JD with ranked candidates, or a single labeled CV/JD pair
+type evalLine struct {
+	query     EvalQuery
+	candidate EvalCandidate
+}
+
+// UnmarshalJSON decodes the line as both shapes; "id" lands in both
+func (l *evalLine) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &l.query); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &l.candidate)
+}
+
+// ParseEvalDataset reads a JSONL dataset. Each line is either a JD with ranked candidates
+// ({"jd_file": ..., "candidates": [{"cv_file": ..., "score": 3}, ...]}) or a labeled pair
+// ({"jd_file": ..., "cv_file": ..., "score": 72}); pairs with the same JD are grouped into one
+// query. Documents are inline ("cv", "jd") or files relative to dir ("cv_file", "jd_file").
+func ParseEvalDataset(r io.Reader, dir string) ([]EvalQuery, error) {
+	var queries []EvalQuery
+	byJD := make(map[string]int)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var line evalLine
+		if err := json.Unmarshal([]byte(text), &line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		jd, err := evalDocument(line.query.JD, line.query.JDFile, dir)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: jd: %w", lineNo, err)
+		}
+
+		// A ranked line's "id" names the query; a pair line's names the candidate
+		queryID, candidates := line.query.ID, line.query.Candidates
+		if len(candidates) == 0 {
+			queryID, candidates = "", []EvalCandidate{line.candidate}
+		}
+		if queryID == "" {
+			queryID = line.query.JDFile
+		}
+		for i := range candidates {
+			if candidates[i].CV, err = evalDocument(candidates[i].CV, candidates[i].CVFile, dir); err != nil {
+				return nil, fmt.Errorf("line %d: candidate %d: %w", lineNo, i+1, err)
+			}
+			if candidates[i].ID == "" {
+				candidates[i].ID = candidates[i].CVFile
+			}
+		}
+
+		if i, ok := byJD[jd]; ok {
+			queries[i].Candidates = append(queries[i].Candidates, candidates...)
+			continue
+		}
+		byJD[jd] = len(queries)
+		queries = append(queries, EvalQuery{ID: queryID, JD: jd, Candidates: candidates})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("dataset has no labeled pairs")
+	}
+	return queries, nil
+}
+
+// evalDocument returns inline content or reads it from a file relative to dir
+func evalDocument(inline, file, dir string) (string, error) {
+	if inline != "" {
+		return inline, nil
+	}
+	if file == "" {
+		return "", fmt.Errorf("no content or file given")
+	}
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(dir, file)
+	}
+	// #nosec G304 - Dataset paths are supplied by the operator running the evaluation
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// EvalMetrics measure how well scores agree with human labels
+type EvalMetrics struct {
+	PrecisionAtK float64 `json:"precision_at_k"` // Mean share of relevant CVs among each JD's top k
+	NDCG         float64 `json:"ndcg"`           // Mean NDCG@k with the labels as gains
+	Spearman     float64 `json:"spearman"`       // Rank correlation of scores and labels over all pairs
+}
+
+// EvalAblation is the metrics with one score component removed from the weights
+type EvalAblation struct {
+	Component string      `json:"component"`
+	Metrics   EvalMetrics `json:"metrics"`
+	Delta     EvalMetrics `json:"delta"` // Ablated minus full; negative means the component helps
+}
+
+// EvalReport is the outcome of an offline evaluation run
+type EvalReport struct {
+	EngineVersion      string         `json:"engine_version"`
+	DictionaryChecksum string         `json:"dictionary_checksum"`
+	Weights            ScoringWeights `json:"weights"`
+	Queries            int            `json:"queries"`
+	Pairs              int            `json:"pairs"`
+	K                  int            `json:"k"`
+	RelevantThreshold  float64        `json:"relevant_threshold"`
+	Metrics            EvalMetrics    `json:"metrics"`
+	Ablations          []EvalAblation `json:"ablations"`
+}
+
+// evalPair is an analyzed candidate of a query
+type evalPair struct {
+	query     int
+	label     float64
+	breakdown ScoreBreakdown
+}
+
+// Evaluate analyzes every labeled pair and reports precision@k, NDCG@k and Spearman correlation
+// against the labels, plus ablations that drop one score component at a time. Candidates with a
+// label >= relevant count as relevant for precision@k.
+func (e *AnalysisEngine) Evaluate(ctx context.Context, queries []EvalQuery, k int, relevant float64) (*EvalReport, error) {
+	if k <= 0 {
+		return nil, fmt.Errorf("k must be positive, got %d", k)
+	}
+
+	var pairs []evalPair
+	for qi, query := range queries {
+		for _, candidate := range query.Candidates {
+			result, err := e.Analyze(ctx, candidate.CV, query.JD)
+			if err != nil {
+				return nil, fmt.Errorf("query %s, candidate %s: %w", query.ID, candidate.ID, err)
+			}
+			pairs = append(pairs, evalPair{query: qi, label: candidate.Label, breakdown: *result.ScoringBreakdown})
+		}
+	}
+
+	report := &EvalReport{
+		EngineVersion:      EngineVersion,
+		DictionaryChecksum: NewSkillsDictionary().Checksum(),
+		Weights:            e.weights.Normalize(),
+		Queries:            len(queries),
+		Pairs:              len(pairs),
+		K:                  k,
+		RelevantThreshold:  relevant,
+		Metrics:            evalMetrics(pairs, len(queries), e.weights, k, relevant),
+	}
+
+	for i, component := range componentNames {
+		ablated := ablateWeight(e.weights.Normalize(), i)
+		metrics := evalMetrics(pairs, len(queries), ablated, k, relevant)
+		report.Ablations = append(report.Ablations, EvalAblation{
+			Component: component,
+			Metrics:   metrics,
+			Delta: EvalMetrics{
+				PrecisionAtK: metrics.PrecisionAtK - report.Metrics.PrecisionAtK,
+				NDCG:         metrics.NDCG - report.Metrics.NDCG,
+				Spearman:     metrics.Spearman - report.Metrics.Spearman,
+			},
+		})
+	}
+	return report, nil
+}
+
+// ablateWeight zeroes one component (in componentNames order); the rest are renormalized when scored
+func ablateWeight(w ScoringWeights, component int) ScoringWeights {
+	fields := []*float64{&w.SkillCoverage, &w.Experience, &w.TermSimilarity, &w.OverallMatch, &w.Proficiency}
+	*fields[component] = 0
+	return w
+}
+
+// evalMetrics scores every pair with the weights and compares the scores with the labels
+func evalMetrics(pairs []evalPair, queries int, weights ScoringWeights, k int, relevant float64) EvalMetrics {
+	scores := make([]float64, len(pairs))
+	labels := make([]float64, len(pairs))
+	perQuery := make([][]int, queries)
+	for i, pair := range pairs {
+		scores[i] = weightedPoints(&pair.breakdown, weights)
+		labels[i] = pair.label
+		perQuery[pair.query] = append(perQuery[pair.query], i)
+	}
+
+	var metrics EvalMetrics
+	counted := 0
+	for _, members := range perQuery {
+		if len(members) == 0 {
+			continue
+		}
+		ranked := append([]int(nil), members...)
+		sort.SliceStable(ranked, func(a, b int) bool { return scores[ranked[a]] > scores[ranked[b]] })
+		rankedLabels := make([]float64, len(ranked))
+		for i, idx := range ranked {
+			rankedLabels[i] = labels[idx]
+		}
+		metrics.PrecisionAtK += PrecisionAtK(rankedLabels, k, relevant)
+		metrics.NDCG += NDCG(rankedLabels, k)
+		counted++
+	}
+	if counted > 0 {
+		metrics.PrecisionAtK /= float64(counted)
+		metrics.NDCG /= float64(counted)
+	}
+	metrics.Spearman = Spearman(scores, labels)
+	return metrics
+}
+
+// PrecisionAtK returns the share of relevant labels among the first k of a ranking
+// (fewer when the ranking is shorter)
+func PrecisionAtK(rankedLabels []float64, k int, relevant float64) float64 {
+	if k > len(rankedLabels) {
+		k = len(rankedLabels)
+	}
+	if k == 0 {
+		return 0
+	}
+	hits := 0
+	for _, label := range rankedLabels[:k] {
+		if label >= relevant {
+			hits++
+		}
+	}
+	return float64(hits) / float64(k)
+}
+
+// NDCG returns the normalized discounted cumulative gain of the first k of a ranking, with the
+// labels as linear gains. A ranking whose labels are all zero scores 0.
+func NDCG(rankedLabels []float64, k int) float64 {
+	ideal := append([]float64(nil), rankedLabels...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(ideal)))
+	idealDCG := dcg(ideal, k)
+	if idealDCG == 0 {
+		return 0
+	}
+	return dcg(rankedLabels, k) / idealDCG
+}
+
+// dcg returns the discounted cumulative gain of the first k labels
+func dcg(labels []float64, k int) float64 {
+	total := 0.0
+	for i, label := range labels {
+		if i >= k {
+			break
+		}
+		total += label / math.Log2(float64(i+2))
+	}
+	return total
+}
+
+// Spearman returns the rank correlation of two series, with tied values sharing their average
+// rank, or 0 when either series is constant
+func Spearman(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) < 2 {
+		return 0
+	}
+	ra, rb := ranks(a), ranks(b)
+
+	meanA, meanB := 0.0, 0.0
+	for i := range ra {
+		meanA += ra[i]
+		meanB += rb[i]
+	}
+	meanA /= float64(len(ra))
+	meanB /= float64(len(rb))
+
+	cov, varA, varB := 0.0, 0.0, 0.0
+	for i := range ra {
+		da, db := ra[i]-meanA, rb[i]-meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
+
+// ranks returns the 1-based ranks of values, averaging ties
+func ranks(values []float64) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+
+	result := make([]float64, len(values))
+	for i := 0; i < len(order); {
+		j := i
+		for j < len(order) && values[order[j]] == values[order[i]] {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for _, idx := range order[i:j] {
+			result[idx] = rank
+		}
+		i = j
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package analysis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrecisionAtK(t *testing.T) {
+	ranked := []float64{3, 0, 2, 1, 0}
+
+	if got := PrecisionAtK(ranked, 2, 1); !almostEqual(got, 0.5, 0.001) {
+		t.Errorf("Expected precision@2 of 0.5, got %v", got)
+	}
+	if got := PrecisionAtK(ranked, 4, 2); !almostEqual(got, 0.5, 0.001) {
+		t.Errorf("Expected precision@4 of 0.5 at threshold 2, got %v", got)
+	}
+	// k beyond the ranking uses the whole ranking
+	if got := PrecisionAtK(ranked[:2], 5, 1); !almostEqual(got, 0.5, 0.001) {
+		t.Errorf("Expected precision of 0.5 over a short ranking, got %v", got)
+	}
+	if got := PrecisionAtK(nil, 5, 1); got != 0 {
+		t.Errorf("Expected 0 for an empty ranking, got %v", got)
+	}
+}
+
+func TestNDCG(t *testing.T) {
+	if got := NDCG([]float64{3, 2, 1, 0}, 4); !almostEqual(got, 1, 0.001) {
+		t.Errorf("Expected a perfect ranking to score 1, got %v", got)
+	}
+
+	// Swapping the top two: (2 + 3/log2(3)) / (3 + 2/log2(3))
+	if got := NDCG([]float64{2, 3}, 2); !almostEqual(got, 0.9134, 0.001) {
+		t.Errorf("Expected NDCG of 0.913, got %v", got)
+	}
+
+	// Only the top k counts: the best candidate ranked third is invisible at k=2
+	if got := NDCG([]float64{0, 0, 3}, 2); got != 0 {
+		t.Errorf("Expected 0 with no gain in the top 2, got %v", got)
+	}
+	if got := NDCG([]float64{0, 0}, 2); got != 0 {
+		t.Errorf("Expected 0 when every label is zero, got %v", got)
+	}
+}
+
+func TestSpearman(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float64
+		want float64
+	}{
+		{"same order", []float64{1, 2, 3, 4}, []float64{10, 20, 30, 40}, 1},
+		{"reversed", []float64{1, 2, 3, 4}, []float64{4, 3, 2, 1}, -1},
+		{"monotonic but nonlinear", []float64{1, 2, 3}, []float64{1, 10, 1000}, 1},
+		{"ties share ranks", []float64{1, 2, 2, 3}, []float64{1, 2, 3, 4}, 0.9487},
+		{"constant series", []float64{1, 1, 1}, []float64{1, 2, 3}, 0},
+		{"too short", []float64{1}, []float64{1}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Spearman(tt.a, tt.b); !almostEqual(got, tt.want, 0.001) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseEvalDataset(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "jd.md"), []byte("# Backend Engineer\n\nGo, Kubernetes"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "alice.md"), []byte("# Alice\n\nGo, Kubernetes"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dataset := strings.Join([]string{
+		`# JD with ranked candidates`,
+		`{"id": "frontend", "jd": "React engineer", "candidates": [{"id": "bob", "cv": "React", "score": 3}, {"id": "carol", "cv": "Vue", "score": 1}]}`,
+		``,
+		`{"id": "alice", "jd_file": "jd.md", "cv_file": "alice.md", "score": 80}`,
+		`{"jd_file": "jd.md", "cv": "PHP developer", "score": 20}`,
+	}, "\n")
+
+	queries, err := ParseEvalDataset(strings.NewReader(dataset), dir)
+	if err != nil {
+		t.Fatalf("ParseEvalDataset failed: %v", err)
+	}
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 queries, got %d", len(queries))
+	}
+
+	frontend := queries[0]
+	if frontend.ID != "frontend" || len(frontend.Candidates) != 2 || frontend.Candidates[0].ID != "bob" || frontend.Candidates[0].Label != 3 {
+		t.Errorf("Unexpected ranked query: %+v", frontend)
+	}
+
+	// Pairs sharing a JD form one query named after the JD file
+	backend := queries[1]
+	if backend.ID != "jd.md" || !strings.Contains(backend.JD, "Kubernetes") {
+		t.Errorf("Unexpected pair query: %+v", backend)
+	}
+	if len(backend.Candidates) != 2 {
+		t.Fatalf("Expected the pairs to be grouped, got %+v", backend.Candidates)
+	}
+	if backend.Candidates[0].ID != "alice" || !strings.Contains(backend.Candidates[0].CV, "Alice") || backend.Candidates[0].Label != 80 {
+		t.Errorf("Expected the CV file to be read, got %+v", backend.Candidates[0])
+	}
+	if backend.Candidates[1].CV != "PHP developer" {
+		t.Errorf("Expected the inline CV, got %+v", backend.Candidates[1])
+	}
+}
+
+func TestParseEvalDataset_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		dataset string
+		want    string
+	}{
+		{"invalid json", `{"jd": `, "line 1"},
+		{"missing file", `{"jd_file": "missing.md", "cv": "Go", "score": 1}`, "line 1: jd"},
+		{"missing cv", `{"jd": "Go", "score": 1}`, "candidate 1"},
+		{"empty", "# nothing here\n", "no labeled pairs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEvalDataset(strings.NewReader(tt.dataset), t.TempDir())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected an error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	jd := `# Backend Engineer
+
+## Requirements
+- Kubernetes
+- PostgreSQL
+- Docker`
+	queries := []EvalQuery{{
+		ID: "backend",
+		JD: jd,
+		Candidates: []EvalCandidate{
+			{ID: "strong", CV: "# Strong\n\n## Skills\nKubernetes, PostgreSQL, Docker", Label: 3},
+			{ID: "partial", CV: "# Partial\n\n## Skills\nKubernetes, Docker", Label: 2},
+			{ID: "weak", CV: "# Weak\n\n## Skills\nPhotoshop, Illustrator", Label: 0},
+		},
+	}}
+
+	report, err := NewAnalysisEngine().Evaluate(context.Background(), queries, 2, 2)
+	if err != nil {
+		t.Fatalf("Evaluate failed: %v", err)
+	}
+
+	if report.Queries != 1 || report.Pairs != 3 || report.K != 2 || report.EngineVersion != EngineVersion {
+		t.Errorf("Unexpected report header: %+v", report)
+	}
+	if !almostEqual(report.Metrics.PrecisionAtK, 1, 0.001) || !almostEqual(report.Metrics.NDCG, 1, 0.001) {
+		t.Errorf("Expected the labeled order to be reproduced, got %+v", report.Metrics)
+	}
+	if !almostEqual(report.Metrics.Spearman, 1, 0.001) {
+		t.Errorf("Expected perfect rank correlation, got %v", report.Metrics.Spearman)
+	}
+
+	if len(report.Ablations) != len(componentNames) {
+		t.Fatalf("Expected one ablation per component, got %d", len(report.Ablations))
+	}
+	for i, ablation := range report.Ablations {
+		if ablation.Component != componentNames[i] {
+			t.Errorf("Expected ablation %d to be %s, got %s", i, componentNames[i], ablation.Component)
+		}
+		if !almostEqual(ablation.Delta.NDCG, ablation.Metrics.NDCG-report.Metrics.NDCG, 0.0001) {
+			t.Errorf("Expected delta to be ablated minus full for %s", ablation.Component)
+		}
+	}
+
+	if _, err := NewAnalysisEngine().Evaluate(context.Background(), queries, 0, 1); err == nil {
+		t.Error("Expected an error for k = 0")
+	}
+}
+
+func TestAblateWeight(t *testing.T) {
+	weights := ablateWeight(NewDefaultWeights(), 1)
+	if weights.Experience != 0 || weights.SkillCoverage != NewDefaultWeights().SkillCoverage {
+		t.Errorf("Expected only experience to be zeroed, got %+v", weights)
+	}
+}
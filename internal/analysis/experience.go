@@ -0,0 +1,199 @@
+package analysis
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ExperienceRange is a span of years parsed from an experience phrase.
+// Max is 0 when the phrase has no upper bound ("5+ years") and Min is 0 when it has no lower bound ("up to 2 years").
+type ExperienceRange struct {
+	Min int `json:"min"`
+	Max int `json:"max,omitempty"`
+}
+
+// experienceWindow is how many words may separate a skill from its experience phrase
+const experienceWindow = 8
+
+// numberWords maps English and Russian numerals (including Russian case forms) to their values
+var numberWords = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11,
+	"twelve": 12, "fifteen": 15, "twenty": 20,
+	"один": 1, "одного": 1, "одной": 1, "полтора": 1, "полутора": 1,
+	"два": 2, "две": 2, "двух": 2, "три": 3, "трех": 3, "трёх": 3,
+	"четыре": 4, "четырех": 4, "четырёх": 4, "пять": 5, "пяти": 5,
+	"шесть": 6, "шести": 6, "семь": 7, "семи": 7, "восемь": 8, "восьми": 8,
+	"девять": 9, "девяти": 9, "десять": 10, "десяти": 10,
+}
+
+// Qualifiers that turn a single number into a lower or upper bound
+var (
+	minExperiencePrefixes = []string{
+		"more than", "no less than", "not less than", "at least", "minimum of", "minimum", "min.", "min",
+		"over", "upwards of", "from", "between",
+		"более", "больше", "свыше", "не менее", "не меньше", "минимум", "от",
+	}
+	maxExperiencePrefixes = []string{
+		"up to", "no more than", "not more than", "less than", "under", "at most", "maximum of", "maximum", "max.", "max",
+		"до", "не более", "не больше", "менее", "меньше", "максимум",
+	}
+	minExperienceSuffixes = []string{
+		"or more", "or longer", "and above", "or above", "and more", "plus", "и более", "и больше",
+	}
+)
+
+// experiencePattern matches "[qualifier] N[-M][+] [full] years|decades [or more]" in English and Russian.
+// A unit is required, so version numbers like "Java 17" never match.
+var experiencePattern = regexp.MustCompile(
+	`(?:(?P<prefix>` + alternation(minExperiencePrefixes, maxExperiencePrefixes) + `)\s+)?` +
+		`(?P<lo>` + numberAlternation() + `)` +
+		`(?:\s*(?:-|–|—|to|or|and|до|или|и)\s*(?P<hi>` + numberAlternation() + `))?` +
+		`(?P<plus>\s*\+)?` +
+		`\s*(?:full\s+|полных\s+)?` +
+		`(?P<unit>years|year|yrs|yr|лет|года|годов|год|decades|decade|десятилетий|десятилетия|десятилетие)` +
+		`(?P<suffix>\s+(?:` + alternation(minExperienceSuffixes) + `))?`,
+)
+
+// experienceMatch is a parsed experience phrase and its byte offsets in the source text
+type experienceMatch struct {
+	Range      ExperienceRange
+	Start, End int
+}
+
+// ParseExperience parses the first experience phrase in text, such as "5+ years", "3-5 years",
+// "over a decade", "более 5 лет" or "3 года опыта"
+func ParseExperience(text string) (ExperienceRange, bool) {
+	matches := findExperience(strings.ToLower(text))
+	if len(matches) == 0 {
+		return ExperienceRange{}, false
+	}
+	return matches[0].Range, true
+}
+
+// findExperience returns all experience phrases in lowercased text
+func findExperience(text string) []experienceMatch {
+	var matches []experienceMatch
+	names := experiencePattern.SubexpNames()
+
+	for pos := 0; pos < len(text); {
+		loc := experiencePattern.FindStringSubmatchIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+		for i := range loc {
+			if loc[i] >= 0 {
+				loc[i] += pos
+			}
+		}
+
+		// Reject matches inside a longer word or number ("2019 years", "admin 5 years"),
+		// retrying from the next rune so a shorter match can still be found
+		if r, _ := utf8.DecodeLastRuneInString(text[:loc[0]]); loc[0] > 0 && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			_, size := utf8.DecodeRuneInString(text[loc[0]:])
+			pos = loc[0] + size
+			continue
+		}
+		pos = loc[1]
+		if r, _ := utf8.DecodeRuneInString(text[loc[1]:]); loc[1] < len(text) && unicode.IsLetter(r) {
+			continue
+		}
+
+		groups := make(map[string]string, len(names))
+		for i, name := range names {
+			if name != "" && loc[2*i] >= 0 {
+				groups[name] = text[loc[2*i]:loc[2*i+1]]
+			}
+		}
+
+		unit := groups["unit"]
+		decade := strings.HasPrefix(unit, "decade") || strings.HasPrefix(unit, "десятилет")
+		lo := experienceNumber(groups["lo"])
+		// "a"/"an" only counts as a numeral for decades ("a year ago" is not experience)
+		if lo == 0 || (!decade && (groups["lo"] == "a" || groups["lo"] == "an")) {
+			continue
+		}
+
+		multiplier := 1
+		if decade {
+			multiplier = 10
+		}
+
+		var r ExperienceRange
+		switch {
+		case groups["hi"] != "":
+			hi := experienceNumber(groups["hi"])
+			if hi < lo {
+				lo, hi = hi, lo
+			}
+			r = ExperienceRange{Min: lo * multiplier, Max: hi * multiplier}
+		case containsString(maxExperiencePrefixes, groups["prefix"]) && groups["plus"] == "" && groups["suffix"] == "":
+			r = ExperienceRange{Max: lo * multiplier}
+		default:
+			r = ExperienceRange{Min: lo * multiplier}
+		}
+
+		matches = append(matches, experienceMatch{Range: r, Start: loc[0], End: loc[1]})
+	}
+
+	return matches
+}
+
+// experienceNumber converts a digit or word numeral to years, truncating fractions ("1.5" is 1)
+func experienceNumber(s string) int {
+	if value, ok := numberWords[s]; ok {
+		return value
+	}
+	if i := strings.IndexAny(s, ".,"); i >= 0 {
+		s = s[:i]
+	}
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// numberAlternation builds the regexp alternation for numerals, longest first
+func numberAlternation() string {
+	words := make([]string, 0, len(numberWords))
+	for word := range numberWords {
+		words = append(words, word)
+	}
+	return `\d{1,2}(?:[.,]\d+)?|` + alternation(words)
+}
+
+// alternation joins phrases into a regexp alternation, longest first so "more than" wins over "more"
+func alternation(lists ...[]string) string {
+	var phrases []string
+	for _, list := range lists {
+		phrases = append(phrases, list...)
+	}
+	sort.Slice(phrases, func(i, j int) bool {
+		if len(phrases[i]) != len(phrases[j]) {
+			return len(phrases[i]) > len(phrases[j])
+		}
+		return phrases[i] < phrases[j]
+	})
+
+	quoted := make([]string, len(phrases))
+	for i, phrase := range phrases {
+		quoted[i] = strings.ReplaceAll(regexp.QuoteMeta(phrase), " ", `\s+`)
+	}
+	return strings.Join(quoted, "|")
+}
+
+// containsString reports whether list contains s, comparing with collapsed whitespace
+func containsString(list []string, s string) bool {
+	s = strings.Join(strings.Fields(s), " ")
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package analysis
+
+import "testing"
+
+func TestParseExperience(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ExperienceRange
+		found    bool
+	}{
+		{"5 years of experience", ExperienceRange{Min: 5}, true},
+		{"5+ years", ExperienceRange{Min: 5}, true},
+		{"five years", ExperienceRange{Min: 5}, true},
+		{"3-5 years", ExperienceRange{Min: 3, Max: 5}, true},
+		{"3 to 5 yrs", ExperienceRange{Min: 3, Max: 5}, true},
+		{"between 2 and 4 years", ExperienceRange{Min: 2, Max: 4}, true},
+		{"over a decade", ExperienceRange{Min: 10}, true},
+		{"two decades", ExperienceRange{Min: 20}, true},
+		{"at least 3 years", ExperienceRange{Min: 3}, true},
+		{"4 years or more", ExperienceRange{Min: 4}, true},
+		{"up to 2 years", ExperienceRange{Max: 2}, true},
+		{"1.5 years", ExperienceRange{Min: 1}, true},
+		{"более 5 лет", ExperienceRange{Min: 5}, true},
+		{"3 года опыта", ExperienceRange{Min: 3}, true},
+		{"1 год", ExperienceRange{Min: 1}, true},
+		{"от 3 до 5 лет", ExperienceRange{Min: 3, Max: 5}, true},
+		{"не менее трёх лет", ExperienceRange{Min: 3}, true},
+		{"до 2 лет", ExperienceRange{Max: 2}, true},
+		{"Java 17", ExperienceRange{}, false},
+		{"Python 3.11 and Go 1.22", ExperienceRange{}, false},
+		{"2019 - 2021", ExperienceRange{}, false},
+		{"a year ago", ExperienceRange{}, false},
+		{"admin 5 years", ExperienceRange{Min: 5}, true},
+		{"", ExperienceRange{}, false},
+	}
+
+	for _, tt := range tests {
+		result, found := ParseExperience(tt.input)
+		if found != tt.found || result != tt.expected {
+			t.Errorf("ParseExperience(%q) = %+v, %v, want %+v, %v", tt.input, result, found, tt.expected, tt.found)
+		}
+	}
+}
+
+func TestExtractExperience_Range(t *testing.T) {
+	content := "requirements:\n- 3-5 years of go experience\n- python 3.11\n- kubernetes for at least 2 years"
+
+	tests := []struct {
+		skill    string
+		expected ExperienceRange
+	}{
+		{"go", ExperienceRange{Min: 3, Max: 5}},
+		{"python", ExperienceRange{}},
+		{"kubernetes", ExperienceRange{Min: 2}},
+	}
+
+	for _, tt := range tests {
+		if result := extractExperience(tt.skill, content); result != tt.expected {
+			t.Errorf("extractExperience(%q) = %+v, want %+v", tt.skill, result, tt.expected)
+		}
+	}
+}
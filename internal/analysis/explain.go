@@ -0,0 +1,253 @@
+package analysis
+
+import (
+	"context"
+	"math"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	bleveanalysis "github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ru"
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Explanation factor kinds
+const (
+	FactorSkill = "skill"
+	FactorTerm  = "term"
+)
+
+// Score components, as named in ScoreBreakdown
+const (
+	ComponentSkillCoverage  = "skill_coverage"
+	ComponentExperience     = "experience_match"
+	ComponentTermSimilarity = "term_similarity"
+	ComponentOverallMatch   = "overall_match"
+	ComponentProficiency    = "proficiency_alignment"
+)
+
+// explainedFactors caps how many skills and terms are measured in each direction; every factor
+// costs one extra scoring run
+const explainedFactors = 5
+
+// minFactorTermLength is the shortest BM25 term explained as a factor; shorter ones are
+// fragments and abbreviations nobody would add to a CV
+const minFactorTermLength = 3
+
+// factorStopWords are the English and Russian stop words BM25 indexes as terms but which
+// are never worth explaining
+var factorStopWords = func() bleveanalysis.TokenMap {
+	words := bleveanalysis.NewTokenMap()
+	_ = words.LoadBytes(en.EnglishStopWords)
+	_ = words.LoadBytes(ru.RussianStopWords)
+	return words
+}()
+
+// ScoreExplanation attributes a weighted score to its components and to the skills and terms behind them
+type ScoreExplanation struct {
+	Score      float64                 `json:"score"` // Unrounded weighted score, 0-100
+	Components []ComponentContribution `json:"components"`
+	// BestImprovement is the missing factor whose addition would raise the score the most
+	BestImprovement *FactorContribution `json:"best_improvement,omitempty"`
+}
+
+// ComponentContribution is one score component and the factors that move it
+type ComponentContribution struct {
+	Component string               `json:"component"`
+	Value     float64              `json:"value"`  // Component score, 0-1
+	Weight    float64              `json:"weight"` // Normalized weight
+	Points    float64              `json:"points"` // Value x weight, in score points
+	Factors   []FactorContribution `json:"factors,omitempty"`
+}
+
+// FactorContribution is a skill or term with its effect on the weighted score
+type FactorContribution struct {
+	Kind    string `json:"kind"` // FactorSkill or FactorTerm
+	Name    string `json:"name"`
+	Present bool   `json:"present"` // Whether the CV mentions it
+	// Delta is the score points the factor adds when present, measured by rescoring the CV without
+	// it, or the points its absence costs (negative), measured by rescoring with it added
+	Delta float64 `json:"delta"`
+	// Component is the score component the factor moves the most
+	Component string `json:"component"`
+}
+
+// weightedPoints returns the unrounded weighted score of a breakdown, 0-100
+func weightedPoints(b *ScoreBreakdown, w ScoringWeights) float64 {
+	points := componentPoints(b, w)
+	total := 0.0
+	for _, p := range points {
+		total += p
+	}
+	return total
+}
+
+// componentPoints returns each component's weighted points, in ComponentSkillCoverage order
+func componentPoints(b *ScoreBreakdown, w ScoringWeights) [5]float64 {
+	w = w.Normalize()
+	return [5]float64{
+		b.SkillCoverage * w.SkillCoverage * 100,
+		b.Experience * w.Experience * 100,
+		b.TermSimilarity * w.TermSimilarity * 100,
+		b.OverallMatch * w.OverallMatch * 100,
+		b.Proficiency * w.Proficiency * 100,
+	}
+}
+
+// componentNames lists the components in componentPoints order
+var componentNames = [5]string{ComponentSkillCoverage, ComponentExperience, ComponentTermSimilarity, ComponentOverallMatch, ComponentProficiency}
+
+// Explain attributes an analysis result to its score components and measures the individual effect
+// of the top JD skills and terms the CV has or lacks by rescoring the CV with each one removed or added
+func (e *AnalysisEngine) Explain(ctx context.Context, cvContent, jdContent string, result *AnalysisResult) (*ScoreExplanation, error) {
+	if result.ScoringBreakdown == nil {
+		return nil, nil
+	}
+
+	weights := e.weights.Normalize()
+	basePoints := componentPoints(result.ScoringBreakdown, e.weights)
+	componentWeights := [5]float64{weights.SkillCoverage, weights.Experience, weights.TermSimilarity, weights.OverallMatch, weights.Proficiency}
+	componentValues := [5]float64{
+		result.ScoringBreakdown.SkillCoverage,
+		result.ScoringBreakdown.Experience,
+		result.ScoringBreakdown.TermSimilarity,
+		result.ScoringBreakdown.OverallMatch,
+		result.ScoringBreakdown.Proficiency,
+	}
+
+	explanation := &ScoreExplanation{Score: roundTenth(weightedPoints(result.ScoringBreakdown, e.weights))}
+	for i, name := range componentNames {
+		explanation.Components = append(explanation.Components, ComponentContribution{
+			Component: name,
+			Value:     componentValues[i],
+			Weight:    componentWeights[i],
+			Points:    roundTenth(basePoints[i]),
+		})
+	}
+
+	// measure rescores the CV with one edit and attributes the change to the component it moves most
+	measure := func(kind, name string, present bool) (FactorContribution, error) {
+		edit := ScoreEdit{Kind: EditAddSkill, Skill: name}
+		if present {
+			edit.Kind = EditRemoveSkill
+		}
+		edited, err := e.Analyze(ctx, ApplyScoreEdits(cvContent, []ScoreEdit{edit}), jdContent)
+		if err != nil {
+			return FactorContribution{}, err
+		}
+
+		editedPoints := componentPoints(edited.ScoringBreakdown, e.weights)
+		factor := FactorContribution{Kind: kind, Name: name, Present: present}
+		largest := -1.0
+		for i := range basePoints {
+			change := basePoints[i] - editedPoints[i]
+			if !present {
+				change = -change
+			}
+			factor.Delta += change
+			if math.Abs(change) > largest {
+				largest = math.Abs(change)
+				factor.Component = componentNames[i]
+			}
+		}
+		// Absent factors are costs: the points they would add, negated
+		if !present {
+			factor.Delta = -factor.Delta
+		}
+		factor.Delta = roundTenth(factor.Delta)
+		return factor, nil
+	}
+
+	dict := NewSkillsDictionary()
+	jd := parse.ParseJD(jdContent)
+	jdText := jd.ScoringText()
+	if jdText == "" {
+		jdText = jdContent
+	}
+	cvSkills := affirmedSkills(ExtractSkills(ctx, cvContent, dict))
+	var matched, missing []string
+	skillNames := make(map[string]bool)
+	for _, skill := range withoutNegated(ExtractSkills(ctx, jdText, dict)) {
+		skillNames[skill.Name] = true
+		if cvSkills[skill.Name] {
+			matched = append(matched, skill.Name)
+		} else {
+			missing = append(missing, skill.Name)
+		}
+	}
+
+	var factors []FactorContribution
+	candidates := []struct {
+		kind    string
+		names   []string
+		present bool
+	}{
+		{FactorSkill, matched, true},
+		{FactorSkill, missing, false},
+		{FactorTerm, factorTerms(result.TopSkills, skillNames), true},
+		{FactorTerm, factorTerms(result.MissingSkills, skillNames), false},
+	}
+	for _, c := range candidates {
+		for i, name := range c.names {
+			if i >= explainedFactors {
+				break
+			}
+			factor, err := measure(c.kind, name, c.present)
+			if err != nil {
+				return nil, err
+			}
+			if factor.Delta == 0 {
+				continue
+			}
+			factors = append(factors, factor)
+			if !factor.Present && (explanation.BestImprovement == nil || factor.Delta < explanation.BestImprovement.Delta) {
+				best := factor
+				explanation.BestImprovement = &best
+			}
+		}
+	}
+
+	// Largest effects first within each component
+	sort.SliceStable(factors, func(i, j int) bool {
+		return math.Abs(factors[i].Delta) > math.Abs(factors[j].Delta)
+	})
+	for _, factor := range factors {
+		for i := range explanation.Components {
+			if explanation.Components[i].Component == factor.Component {
+				explanation.Components[i].Factors = append(explanation.Components[i].Factors, factor)
+			}
+		}
+	}
+
+	return explanation, nil
+}
+
+// factorTerms returns the BM25 terms worth explaining: not skills (explained as skills already),
+// stop words, numbers or short fragments
+func factorTerms(terms []string, skills map[string]bool) []string {
+	var kept []string
+	for _, term := range terms {
+		if skills[term] || factorStopWords[term] || utf8.RuneCountInString(term) < minFactorTermLength || !hasLetter(term) {
+			continue
+		}
+		kept = append(kept, term)
+	}
+	return kept
+}
+
+// hasLetter reports whether s contains a letter, so "2020" or "5+" is not a term
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// roundTenth rounds to one decimal place
+func roundTenth(v float64) float64 {
+	return math.Round(v*10) / 10
+}
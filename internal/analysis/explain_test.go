@@ -0,0 +1,108 @@
+package analysis
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEngine_Explain(t *testing.T) {
+	engine := NewAnalysisEngine()
+	cv := "# Jane Doe\n\n## Experience\n### Backend Developer - Acme\n2020 - Present\n- Built REST APIs in Go with PostgreSQL\n\n## Skills\nGo, PostgreSQL\n"
+	jd := "# Backend Engineer\n\n## Requirements\n- Go\n- PostgreSQL\n- Kubernetes\n- Kafka\n"
+
+	result, err := engine.Analyze(context.Background(), cv, jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	explanation, err := engine.Explain(context.Background(), cv, jd, result)
+	if err != nil {
+		t.Fatalf("Explain failed: %v", err)
+	}
+
+	if len(explanation.Components) != 5 {
+		t.Fatalf("Expected 5 components, got %+v", explanation.Components)
+	}
+	total := 0.0
+	for _, c := range explanation.Components {
+		total += c.Points
+		if !almostEqual(c.Points, roundTenth(c.Value*c.Weight*100), 0.001) {
+			t.Errorf("Expected %s points to be value x weight, got %+v", c.Component, c)
+		}
+	}
+	if math.Abs(total-explanation.Score) > 0.3 || int(explanation.Score) != result.WeightedScore {
+		t.Errorf("Expected components to add up to the score %d, got %v (%v)", result.WeightedScore, total, explanation.Score)
+	}
+
+	factors := make(map[string]FactorContribution)
+	for _, c := range explanation.Components {
+		for _, f := range c.Factors {
+			if f.Component != c.Component {
+				t.Errorf("Expected factor %s under its component %s, found under %s", f.Name, f.Component, c.Component)
+			}
+			factors[f.Kind+":"+f.Name] = f
+		}
+	}
+	if f, ok := factors[FactorSkill+":postgresql"]; !ok || !f.Present || f.Delta <= 0 {
+		t.Errorf("Expected postgresql to push the score up, got %+v", f)
+	}
+	if f, ok := factors[FactorSkill+":kubernetes"]; !ok || f.Present || f.Delta >= 0 {
+		t.Errorf("Expected missing kubernetes to push the score down, got %+v", f)
+	}
+
+	best := explanation.BestImprovement
+	if best == nil || best.Present || best.Kind != FactorSkill {
+		t.Fatalf("Expected a missing skill as the best improvement, got %+v", best)
+	}
+	for _, f := range factors {
+		if !f.Present && f.Delta < best.Delta {
+			t.Errorf("Expected %s to be the best improvement over %s", f.Name, best.Name)
+		}
+	}
+}
+
+func TestEngine_Explain_RussianTerms(t *testing.T) {
+	jd, err := os.ReadFile(filepath.Join("..", "..", "testdata", "job_ru.md"))
+	if err != nil {
+		t.Fatalf("Failed to read JD: %v", err)
+	}
+	cv := "# Иван\n\n## Опыт работы\n### Разработчик - Acme\n2019 - 2024\n- Разрабатывал микросервисы на Go и Python для 3 команд\n\n## Навыки\nGo, Python, Docker\n"
+
+	engine := NewAnalysisEngine()
+	result, err := engine.Analyze(context.Background(), cv, string(jd))
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	explanation, err := engine.Explain(context.Background(), cv, string(jd), result)
+	if err != nil {
+		t.Fatalf("Explain failed: %v", err)
+	}
+
+	for _, c := range explanation.Components {
+		for _, f := range c.Factors {
+			if f.Kind != FactorTerm {
+				continue
+			}
+			if factorStopWords[f.Name] || !hasLetter(f.Name) || utf8.RuneCountInString(f.Name) < minFactorTermLength {
+				t.Errorf("Expected no stop words, numbers or fragments as factors, got %+v", f)
+			}
+		}
+	}
+}
+
+func TestFactorTerms(t *testing.T) {
+	terms := []string{"и", "в", "опыт", "with", "the", "2020", "5", "go", "микросервисов", "k8s"}
+	got := factorTerms(terms, map[string]bool{"go": true})
+	want := []string{"опыт", "микросервисов", "k8s"}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
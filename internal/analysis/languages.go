@@ -0,0 +1,333 @@
+package analysis
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// LanguageCategory is the category of spoken languages, kept apart from technical skills
+const LanguageCategory = "Spoken Languages"
+
+// Language level cue windows: "fluent in spoken English" (before), "English language at B2 level" (after)
+const (
+	languageLevelBeforeWindow = 3
+	languageLevelAfterWindow  = 4
+)
+
+// cefrRank orders CEFR levels; unknown levels rank 0
+var cefrRank = map[string]int{"A1": 1, "A2": 2, "B1": 3, "B2": 4, "C1": 5, "C2": 6}
+
+// cefrPattern matches a CEFR level token such as "b2", "b2+" or "b2/c1" (the lower level wins)
+var cefrPattern = regexp.MustCompile(`^([abc][12])(?:[^0-9a-z]|$)`)
+
+var (
+	// spokenLanguages maps English language names to canonical names
+	spokenLanguages = map[string]string{
+		"english": "English", "german": "German", "french": "French", "spanish": "Spanish",
+		"russian": "Russian", "chinese": "Chinese", "mandarin": "Chinese", "italian": "Italian",
+		"portuguese": "Portuguese", "japanese": "Japanese", "polish": "Polish", "ukrainian": "Ukrainian",
+		"turkish": "Turkish", "arabic": "Arabic", "dutch": "Dutch", "korean": "Korean", "hindi": "Hindi",
+		"swedish": "Swedish", "czech": "Czech", "hebrew": "Hebrew",
+	}
+	// spokenLanguageStems maps Russian adjective stems to canonical names ("английский", "английского")
+	spokenLanguageStems = []struct{ Stem, Name string }{
+		{"английск", "English"}, {"немецк", "German"}, {"французск", "French"}, {"испанск", "Spanish"},
+		{"русск", "Russian"}, {"китайск", "Chinese"}, {"итальянск", "Italian"}, {"португальск", "Portuguese"},
+		{"японск", "Japanese"}, {"польск", "Polish"}, {"украинск", "Ukrainian"}, {"турецк", "Turkish"},
+		{"арабск", "Arabic"}, {"нидерландск", "Dutch"}, {"корейск", "Korean"}, {"шведск", "Swedish"},
+	}
+	// capitalizedLanguages are also ordinary words and only count when capitalized ("polish the UI")
+	capitalizedLanguages = map[string]bool{"polish": true}
+
+	// languageLevelWords map level words (English and Russian) to CEFR levels
+	languageLevelWords = map[string]string{
+		"native": "C2", "native speaker": "C2", "bilingual": "C2", "mother tongue": "C2",
+		"fluent": "C1", "fluently": "C1", "fluency": "C1", "proficient": "C1", "advanced": "C1",
+		"upper-intermediate": "B2", "upper intermediate": "B2",
+		"intermediate": "B1", "conversational": "B1",
+		"pre-intermediate": "A2", "pre intermediate": "A2", "elementary": "A2", "basic": "A2", "beginner": "A1",
+		"родной": "C2", "носитель": "C2", "свободный": "C1", "свободно": "C1", "свободное": "C1",
+		"продвинутый": "C1", "выше среднего": "B2", "средний": "B1", "разговорный": "B1",
+		"технический": "B1", "базовый": "A2", "начальный": "A1",
+	}
+	// languageCues mark a sentence as stating a language requirement ("spoken English", "знание языка")
+	languageCues = map[string]bool{
+		"language": true, "languages": true, "speak": true, "speaking": true, "spoken": true,
+		"written": true, "communication": true, "level": true,
+		"язык": true, "языка": true, "языком": true, "уровень": true, "уровне": true,
+	}
+)
+
+// LanguageSkill is a spoken language with its normalized CEFR level
+type LanguageSkill struct {
+	Name        string `json:"name"`
+	Category    string `json:"category"`
+	Level       string `json:"level,omitempty"`       // CEFR level, "" when not stated
+	Requirement string `json:"requirement,omitempty"` // "required" or "preferred" for JD languages
+}
+
+// LanguageMatch is the pass/fail result of one JD language requirement
+type LanguageMatch struct {
+	Language    string `json:"language"`
+	Required    string `json:"required,omitempty"` // CEFR level the JD asks for
+	Claimed     string `json:"claimed,omitempty"`  // CEFR level the CV states
+	Requirement string `json:"requirement,omitempty"`
+	Pass        bool   `json:"pass"`
+	Reason      string `json:"reason"`
+}
+
+// languageMention is one occurrence of a spoken language with its sentence context
+type languageMention struct {
+	Name    string
+	Level   string
+	Negated bool
+	Context bool // Stated as a requirement: with a level, a language cue or a requirement cue
+}
+
+// languageName returns the canonical name of a spoken language word, or ""
+func languageName(word, original string) string {
+	if name, ok := spokenLanguages[word]; ok {
+		r, _ := utf8.DecodeRuneInString(original)
+		if capitalizedLanguages[word] && !unicode.IsUpper(r) {
+			return ""
+		}
+		return name
+	}
+	for _, stem := range spokenLanguageStems {
+		if strings.HasPrefix(word, stem.Stem) {
+			return stem.Name
+		}
+	}
+	return ""
+}
+
+// NormalizeLanguageLevel converts a written level ("C1", "B2+", "fluent", "родной") to CEFR, or ""
+func NormalizeLanguageLevel(level string) string {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if m := cefrPattern.FindStringSubmatch(level); m != nil {
+		return strings.ToUpper(m[1])
+	}
+	if cefr, ok := languageLevelWords[level]; ok {
+		return cefr
+	}
+	if cues := findLanguageLevels(strings.Fields(level)); len(cues) > 0 {
+		return cues[0].Level
+	}
+	return ""
+}
+
+// findLanguageLevels finds CEFR levels and level words in a sentence, preferring two-word phrases.
+// Levels reuse proficiencyCue, with CEFR codes as the level.
+func findLanguageLevels(words []string) []proficiencyCue {
+	var cues []proficiencyCue
+	for i := 0; i < len(words); i++ {
+		if i+1 < len(words) {
+			if level, ok := languageLevelWords[words[i]+" "+words[i+1]]; ok {
+				cues = append(cues, proficiencyCue{Level: level, Start: i, End: i + 2})
+				i++
+				continue
+			}
+		}
+		if m := cefrPattern.FindStringSubmatch(words[i]); m != nil {
+			cues = append(cues, proficiencyCue{Level: strings.ToUpper(m[1]), Start: i, End: i + 1})
+		} else if level, ok := languageLevelWords[words[i]]; ok {
+			cues = append(cues, proficiencyCue{Level: level, Start: i, End: i + 1})
+		}
+	}
+	return cues
+}
+
+// findLanguageMentions finds spoken language mentions sentence by sentence. Each level cue
+// belongs to the nearest language ("English C1, German B1"); ties go to the language before it.
+func findLanguageMentions(content string) []languageMention {
+	var mentions []languageMention
+
+	for _, sentence := range sentencePattern.Split(content, -1) {
+		spans := wordSpans(sentence)
+		words := make([]string, len(spans))
+		names := make([]string, len(spans))
+		languages := make([]bool, len(spans))
+		context := false
+		for i, span := range spans {
+			original := sentence[span[0]:span[1]]
+			words[i] = strings.ToLower(original)
+			names[i] = languageName(words[i], original)
+			languages[i] = names[i] != ""
+			if languageCues[words[i]] || requirementCues[words[i]] {
+				context = true
+			}
+		}
+
+		levels := make(map[int]string)
+		distances := make(map[int]int)
+		for _, cue := range findLanguageLevels(words) {
+			i, distance := nearestLanguage(words, languages, cue)
+			if i < 0 {
+				continue
+			}
+			if best, ok := distances[i]; !ok || distance < best {
+				levels[i], distances[i] = cue.Level, distance
+			}
+		}
+
+		for i, name := range names {
+			if name == "" {
+				continue
+			}
+			mentions = append(mentions, languageMention{
+				Name:    name,
+				Level:   levels[i],
+				Negated: negatedBefore(words, languages, i) || negatedAfter(words, i),
+				Context: context || levels[i] != "",
+			})
+		}
+	}
+
+	return mentions
+}
+
+// nearestLanguage returns the index of the language a level cue describes and its distance,
+// or -1 when no language is within the windows
+func nearestLanguage(words []string, languages []bool, cue proficiencyCue) (int, int) {
+	best, bestDistance := -1, languageLevelAfterWindow+1
+	// A language before the cue ("English (C1)") wins ties
+	for i := cue.Start - 1; i >= 0 && cue.Start-i <= languageLevelAfterWindow; i-- {
+		if languages[i] {
+			if !crossesContrast(words, i+1, cue.Start) {
+				best, bestDistance = i, cue.Start-i
+			}
+			break
+		}
+	}
+	for i := cue.End; i < len(words) && i-cue.End+1 <= languageLevelBeforeWindow; i++ {
+		if languages[i] {
+			if distance := i - cue.End + 1; distance < bestDistance && !crossesContrast(words, cue.End, i) {
+				best, bestDistance = i, distance
+			}
+			break
+		}
+	}
+	return best, bestDistance
+}
+
+// ExtractLanguages extracts spoken languages from content with the highest level stated for each.
+// Languages only mentioned as not needed ("German is not required") are left out.
+func ExtractLanguages(content string) []LanguageSkill {
+	return collectLanguages(findLanguageMentions(content), func(languageMention) bool { return true })
+}
+
+// ExtractLanguageRequirements extracts the spoken languages a JD asks for: mentions with a level
+// ("English B2+", "fluent German") or in a requirement sentence ("good spoken English"),
+// but not passing mentions ("our German customers")
+func ExtractLanguageRequirements(content string) []LanguageSkill {
+	return collectLanguages(findLanguageMentions(content), func(m languageMention) bool { return m.Context })
+}
+
+// collectLanguages merges affirmative mentions accepted by keep into one entry per language
+func collectLanguages(mentions []languageMention, keep func(languageMention) bool) []LanguageSkill {
+	var languages []LanguageSkill
+	index := make(map[string]int)
+	for _, m := range mentions {
+		if m.Negated || !keep(m) {
+			continue
+		}
+		i, ok := index[m.Name]
+		if !ok {
+			index[m.Name] = len(languages)
+			languages = append(languages, LanguageSkill{Name: m.Name, Category: LanguageCategory, Level: m.Level})
+			continue
+		}
+		if cefrRank[m.Level] > cefrRank[languages[i].Level] {
+			languages[i].Level = m.Level
+		}
+	}
+	return languages
+}
+
+// extractCVLanguages returns the languages a CV claims: entries of its languages section,
+// plus languages stated with a level anywhere else ("fluent German")
+func extractCVLanguages(content string, cv *parse.CV) []LanguageSkill {
+	listed := make(map[string]string)
+	for _, lang := range cv.Languages {
+		words := strings.Fields(lang.Name)
+		if len(words) == 0 {
+			continue
+		}
+		if name := languageName(strings.ToLower(words[0]), words[0]); name != "" {
+			listed[name] = NormalizeLanguageLevel(lang.Level)
+		}
+	}
+
+	var languages []LanguageSkill
+	for _, lang := range ExtractLanguages(content) {
+		level, ok := listed[lang.Name]
+		if !ok && lang.Level == "" {
+			continue
+		}
+		if cefrRank[level] > cefrRank[lang.Level] {
+			lang.Level = level
+		}
+		languages = append(languages, lang)
+	}
+	return languages
+}
+
+// MatchLanguages checks every JD language requirement against the CV languages.
+// A requirement passes when the CV lists the language at or above the required level.
+func MatchLanguages(cvLanguages, jdLanguages []LanguageSkill) []LanguageMatch {
+	claimed := make(map[string]LanguageSkill, len(cvLanguages))
+	for _, lang := range cvLanguages {
+		claimed[lang.Name] = lang
+	}
+
+	matches := make([]LanguageMatch, 0, len(jdLanguages))
+	for _, jdLang := range jdLanguages {
+		match := LanguageMatch{Language: jdLang.Name, Required: jdLang.Level, Requirement: jdLang.Requirement}
+		cvLang, ok := claimed[jdLang.Name]
+		switch {
+		case !ok:
+			match.Reason = "not listed in CV"
+		case jdLang.Level == "":
+			match.Claimed, match.Pass, match.Reason = cvLang.Level, true, "listed in CV"
+		case cvLang.Level == "":
+			match.Reason = "level not stated in CV"
+		case cefrRank[cvLang.Level] >= cefrRank[jdLang.Level]:
+			match.Claimed, match.Pass, match.Reason = cvLang.Level, true, cvLang.Level+" meets "+jdLang.Level
+		default:
+			match.Claimed, match.Reason = cvLang.Level, cvLang.Level+" below required "+jdLang.Level
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// LanguagesMet reports whether every required (not preferred) language requirement passes
+func LanguagesMet(matches []LanguageMatch) bool {
+	for _, match := range matches {
+		if !match.Pass && match.Requirement != RequirementPreferred {
+			return false
+		}
+	}
+	return true
+}
+
+// markLanguageRequirements tags JD languages as required or preferred, like markSkillRequirements
+func markLanguageRequirements(languages []LanguageSkill, jd *parse.JobDescription) []LanguageSkill {
+	required := make(map[string]bool)
+	for _, lang := range ExtractLanguageRequirements(jd.CoreText()) {
+		required[lang.Name] = true
+	}
+
+	for i := range languages {
+		if required[languages[i].Name] {
+			languages[i].Requirement = RequirementRequired
+		} else {
+			languages[i].Requirement = RequirementPreferred
+		}
+	}
+	return languages
+}
@@ -0,0 +1,147 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+func TestExtractLanguageRequirements(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected []LanguageSkill
+	}{
+		{"English B2+ is required", []LanguageSkill{{Name: "English", Level: "B2"}}},
+		{"Fluent German and basic French", []LanguageSkill{{Name: "German", Level: "C1"}, {Name: "French", Level: "A2"}}},
+		{"Good spoken English", []LanguageSkill{{Name: "English"}}},
+		{"Английский язык на уровне B2", []LanguageSkill{{Name: "English", Level: "B2"}}},
+		{"Свободный английский", []LanguageSkill{{Name: "English", Level: "C1"}}},
+		{"Upper intermediate English", []LanguageSkill{{Name: "English", Level: "B2"}}},
+		{"Work with our German customers", nil},
+		{"German is not required", nil},
+		{"Polish the UI before release", nil},
+	}
+
+	for _, tt := range tests {
+		languages := ExtractLanguageRequirements(tt.content)
+		if len(languages) != len(tt.expected) {
+			t.Errorf("For %q, expected %d languages, got %+v", tt.content, len(tt.expected), languages)
+			continue
+		}
+		for i, lang := range languages {
+			if lang.Name != tt.expected[i].Name || lang.Level != tt.expected[i].Level {
+				t.Errorf("For %q, expected %+v, got %+v", tt.content, tt.expected[i], lang)
+			}
+			if lang.Category != LanguageCategory {
+				t.Errorf("Expected category %q, got %q", LanguageCategory, lang.Category)
+			}
+		}
+	}
+}
+
+func TestExtractLanguages_LevelAssignment(t *testing.T) {
+	languages := ExtractLanguages("English C1, German B1, Русский — родной")
+
+	expected := map[string]string{"English": "C1", "German": "B1", "Russian": "C2"}
+	if len(languages) != len(expected) {
+		t.Fatalf("Expected %d languages, got %+v", len(expected), languages)
+	}
+	for _, lang := range languages {
+		if expected[lang.Name] != lang.Level {
+			t.Errorf("%s: expected level %q, got %q", lang.Name, expected[lang.Name], lang.Level)
+		}
+	}
+}
+
+func TestNormalizeLanguageLevel(t *testing.T) {
+	tests := map[string]string{
+		"C1":                 "C1",
+		"b2+":                "B2",
+		"B2/C1":              "B2",
+		"Fluent":             "C1",
+		"native":             "C2",
+		"родной":             "C2",
+		"upper-intermediate": "B2",
+		"Upper Intermediate": "B2",
+		"working":            "",
+	}
+
+	for level, expected := range tests {
+		if result := NormalizeLanguageLevel(level); result != expected {
+			t.Errorf("NormalizeLanguageLevel(%q) = %q, want %q", level, result, expected)
+		}
+	}
+}
+
+func TestExtractCVLanguages(t *testing.T) {
+	content := `# Jane Doe
+
+## Experience
+- Supported German customers
+- Fluent French from living in Lyon
+
+## Languages
+- English (C1)
+- Spanish
+`
+	languages := extractCVLanguages(content, parse.ParseCV(content))
+
+	expected := map[string]string{"English": "C1", "Spanish": "", "French": "C1"}
+	if len(languages) != len(expected) {
+		t.Fatalf("Expected %d languages, got %+v", len(expected), languages)
+	}
+	for _, lang := range languages {
+		level, ok := expected[lang.Name]
+		if !ok || level != lang.Level {
+			t.Errorf("Unexpected language %+v", lang)
+		}
+	}
+}
+
+func TestMatchLanguages(t *testing.T) {
+	cvLanguages := []LanguageSkill{{Name: "English", Level: "C1"}, {Name: "German", Level: "B1"}, {Name: "Spanish"}}
+	jdLanguages := []LanguageSkill{
+		{Name: "English", Level: "B2"},
+		{Name: "German", Level: "B2"},
+		{Name: "Spanish", Level: "B1"},
+		{Name: "French"},
+		{Name: "Spanish"},
+	}
+
+	matches := MatchLanguages(cvLanguages, jdLanguages)
+
+	expected := []struct {
+		pass   bool
+		reason string
+	}{
+		{true, "C1 meets B2"},
+		{false, "B1 below required B2"},
+		{false, "level not stated in CV"},
+		{false, "not listed in CV"},
+		{true, "listed in CV"},
+	}
+	for i, match := range matches {
+		if match.Pass != expected[i].pass || match.Reason != expected[i].reason {
+			t.Errorf("%s: expected pass=%v (%s), got %+v", match.Language, expected[i].pass, expected[i].reason, match)
+		}
+	}
+}
+
+func TestLanguagesMet(t *testing.T) {
+	preferredMiss := []LanguageMatch{
+		{Language: "English", Pass: true},
+		{Language: "German", Requirement: RequirementPreferred},
+	}
+	if !LanguagesMet(preferredMiss) {
+		t.Error("Expected a missed preferred language not to fail the check")
+	}
+
+	requiredMiss := []LanguageMatch{{Language: "English", Requirement: RequirementRequired}}
+	if LanguagesMet(requiredMiss) {
+		t.Error("Expected a missed required language to fail the check")
+	}
+
+	if !LanguagesMet(nil) {
+		t.Error("Expected no requirements to pass")
+	}
+}
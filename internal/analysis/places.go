@@ -0,0 +1,167 @@
+package analysis
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Place is a city, country or region resolved from the gazetteer
+type Place struct {
+	Name    string  `json:"name"`
+	Country string  `json:"country,omitempty"` // "" for regions
+	Region  string  `json:"region,omitempty"`  // "EU" or "Europe" for European countries
+	Offset  float64 `json:"utc_offset"`        // Standard UTC offset in hours
+}
+
+// maxPlaceWords is the longest place name in words ("rio de janeiro")
+const maxPlaceWords = 3
+
+// countries maps country names to their region and standard UTC offset (the main zone for large countries)
+var countries = map[string]Place{
+	"Germany": {Region: "EU", Offset: 1}, "Netherlands": {Region: "EU", Offset: 1}, "France": {Region: "EU", Offset: 1},
+	"Spain": {Region: "EU", Offset: 1}, "Italy": {Region: "EU", Offset: 1}, "Poland": {Region: "EU", Offset: 1},
+	"Portugal": {Region: "EU", Offset: 0}, "Ireland": {Region: "EU", Offset: 0}, "Austria": {Region: "EU", Offset: 1},
+	"Czechia": {Region: "EU", Offset: 1}, "Sweden": {Region: "EU", Offset: 1}, "Finland": {Region: "EU", Offset: 2},
+	"Estonia": {Region: "EU", Offset: 2}, "Latvia": {Region: "EU", Offset: 2}, "Lithuania": {Region: "EU", Offset: 2},
+	"Cyprus": {Region: "EU", Offset: 2}, "United Kingdom": {Region: "Europe", Offset: 0},
+	"Switzerland": {Region: "Europe", Offset: 1}, "Serbia": {Region: "Europe", Offset: 1},
+	"Ukraine": {Region: "Europe", Offset: 2}, "Georgia": {Offset: 4}, "Armenia": {Offset: 4}, "Turkey": {Offset: 3},
+	"Russia": {Offset: 3}, "Belarus": {Offset: 3}, "Kazakhstan": {Offset: 5}, "Israel": {Offset: 2},
+	"United Arab Emirates": {Offset: 4}, "India": {Offset: 5.5}, "Singapore": {Offset: 8}, "Japan": {Offset: 9},
+	"Australia": {Offset: 10}, "Brazil": {Offset: -3}, "Argentina": {Offset: -3}, "Mexico": {Offset: -6},
+	"Canada": {Offset: -5}, "United States": {Offset: -5},
+}
+
+// placeNames maps lowercase names (English, Russian and common Russian case forms) to a city's
+// country, a country, or a region
+var placeNames = map[string]string{
+	// Countries
+	"germany": "Germany", "netherlands": "Netherlands", "the netherlands": "Netherlands", "france": "France",
+	"spain": "Spain", "italy": "Italy", "poland": "Poland", "portugal": "Portugal", "ireland": "Ireland",
+	"austria": "Austria", "czechia": "Czechia", "czech republic": "Czechia", "sweden": "Sweden", "finland": "Finland",
+	"estonia": "Estonia", "latvia": "Latvia", "lithuania": "Lithuania", "cyprus": "Cyprus",
+	"uk": "United Kingdom", "united kingdom": "United Kingdom", "england": "United Kingdom",
+	"switzerland": "Switzerland", "serbia": "Serbia", "ukraine": "Ukraine", "georgia": "Georgia",
+	"armenia": "Armenia", "turkey": "Turkey", "russia": "Russia", "belarus": "Belarus", "kazakhstan": "Kazakhstan",
+	"israel": "Israel", "uae": "United Arab Emirates", "united arab emirates": "United Arab Emirates",
+	"india": "India", "singapore": "Singapore", "japan": "Japan", "australia": "Australia", "brazil": "Brazil",
+	"argentina": "Argentina", "mexico": "Mexico", "canada": "Canada", "usa": "United States", "us": "United States",
+	"united states": "United States",
+	"германия":      "Germany", "германии": "Germany", "нидерланды": "Netherlands", "нидерландах": "Netherlands",
+	"франция": "France", "франции": "France", "испания": "Spain", "испании": "Spain", "польша": "Poland",
+	"польше": "Poland", "португалия": "Portugal", "португалии": "Portugal", "кипр": "Cyprus", "кипре": "Cyprus",
+	"сербия": "Serbia", "сербии": "Serbia", "украина": "Ukraine", "украине": "Ukraine", "грузия": "Georgia",
+	"грузии": "Georgia", "армения": "Armenia", "армении": "Armenia", "турция": "Turkey", "турции": "Turkey",
+	"россия": "Russia", "россии": "Russia", "рф": "Russia", "беларусь": "Belarus", "беларуси": "Belarus",
+	"казахстан": "Kazakhstan", "казахстане": "Kazakhstan", "израиль": "Israel", "израиле": "Israel",
+	"оаэ": "United Arab Emirates", "бразилия": "Brazil", "бразилии": "Brazil", "сша": "United States",
+
+	// Cities
+	"berlin": "Germany", "munich": "Germany", "hamburg": "Germany", "frankfurt": "Germany", "amsterdam": "Netherlands",
+	"paris": "France", "madrid": "Spain", "barcelona": "Spain", "milan": "Italy", "warsaw": "Poland",
+	"krakow": "Poland", "lisbon": "Portugal", "dublin": "Ireland", "vienna": "Austria", "prague": "Czechia",
+	"stockholm": "Sweden", "helsinki": "Finland", "tallinn": "Estonia", "riga": "Latvia", "vilnius": "Lithuania",
+	"limassol": "Cyprus", "london": "United Kingdom", "zurich": "Switzerland", "belgrade": "Serbia",
+	"kyiv": "Ukraine", "kiev": "Ukraine", "tbilisi": "Georgia", "yerevan": "Armenia", "istanbul": "Turkey",
+	"moscow": "Russia", "saint petersburg": "Russia", "st petersburg": "Russia", "minsk": "Belarus",
+	"almaty": "Kazakhstan", "astana": "Kazakhstan", "tel aviv": "Israel", "dubai": "United Arab Emirates",
+	"bangalore": "India", "bengaluru": "India", "tokyo": "Japan", "sydney": "Australia",
+	"sao paulo": "Brazil", "são paulo": "Brazil", "rio de janeiro": "Brazil", "buenos aires": "Argentina",
+	"mexico city": "Mexico", "toronto": "Canada", "vancouver": "Canada", "new york": "United States",
+	"san francisco": "United States", "seattle": "United States", "austin": "United States", "boston": "United States",
+	"берлин": "Germany", "берлине": "Germany", "мюнхен": "Germany", "мюнхене": "Germany", "амстердам": "Netherlands",
+	"амстердаме": "Netherlands", "лиссабон": "Portugal", "лиссабоне": "Portugal", "лимассол": "Cyprus",
+	"лимассоле": "Cyprus", "лондон": "United Kingdom", "лондоне": "United Kingdom", "белград": "Serbia",
+	"белграде": "Serbia", "киев": "Ukraine", "киеве": "Ukraine", "тбилиси": "Georgia", "ереван": "Armenia",
+	"ереване": "Armenia", "стамбул": "Turkey", "стамбуле": "Turkey", "москва": "Russia", "москве": "Russia",
+	"санкт-петербург": "Russia", "санкт-петербурге": "Russia", "петербург": "Russia", "петербурге": "Russia",
+	"минск": "Belarus", "минске": "Belarus", "алматы": "Kazakhstan", "астана": "Kazakhstan", "астане": "Kazakhstan",
+	"дубай": "United Arab Emirates", "дубае": "United Arab Emirates",
+
+	// Regions
+	"eu": "EU", "european union": "EU", "europe": "Europe", "ес": "EU", "евросоюз": "EU", "европа": "Europe",
+	"европе": "Europe", "европы": "Europe",
+}
+
+// cityNames gives English display names for cities, keyed by lowercase name
+var cityNames = map[string]string{
+	"berlin": "Berlin", "munich": "Munich", "hamburg": "Hamburg", "frankfurt": "Frankfurt", "amsterdam": "Amsterdam",
+	"paris": "Paris", "madrid": "Madrid", "barcelona": "Barcelona", "milan": "Milan", "warsaw": "Warsaw",
+	"krakow": "Krakow", "lisbon": "Lisbon", "dublin": "Dublin", "vienna": "Vienna", "prague": "Prague",
+	"stockholm": "Stockholm", "helsinki": "Helsinki", "tallinn": "Tallinn", "riga": "Riga", "vilnius": "Vilnius",
+	"limassol": "Limassol", "london": "London", "zurich": "Zurich", "belgrade": "Belgrade", "kyiv": "Kyiv",
+	"kiev": "Kyiv", "tbilisi": "Tbilisi", "yerevan": "Yerevan", "istanbul": "Istanbul", "moscow": "Moscow",
+	"saint petersburg": "Saint Petersburg", "st petersburg": "Saint Petersburg", "minsk": "Minsk",
+	"almaty": "Almaty", "astana": "Astana", "tel aviv": "Tel Aviv", "dubai": "Dubai", "bangalore": "Bangalore",
+	"bengaluru": "Bangalore", "tokyo": "Tokyo", "sydney": "Sydney", "sao paulo": "São Paulo",
+	"são paulo": "São Paulo", "rio de janeiro": "Rio de Janeiro", "buenos aires": "Buenos Aires",
+	"mexico city": "Mexico City", "toronto": "Toronto", "vancouver": "Vancouver", "new york": "New York",
+	"san francisco": "San Francisco", "seattle": "Seattle", "austin": "Austin", "boston": "Boston",
+	"берлин": "Berlin", "берлине": "Berlin", "мюнхен": "Munich", "мюнхене": "Munich", "амстердам": "Amsterdam",
+	"амстердаме": "Amsterdam", "лиссабон": "Lisbon", "лиссабоне": "Lisbon", "лимассол": "Limassol",
+	"лимассоле": "Limassol", "лондон": "London", "лондоне": "London", "белград": "Belgrade", "белграде": "Belgrade",
+	"киев": "Kyiv", "киеве": "Kyiv", "тбилиси": "Tbilisi", "ереван": "Yerevan", "ереване": "Yerevan",
+	"стамбул": "Istanbul", "стамбуле": "Istanbul", "москва": "Moscow", "москве": "Moscow",
+	"санкт-петербург": "Saint Petersburg", "санкт-петербурге": "Saint Petersburg", "петербург": "Saint Petersburg",
+	"петербурге": "Saint Petersburg", "минск": "Minsk", "минске": "Minsk", "алматы": "Almaty", "астана": "Astana",
+	"астане": "Astana", "дубай": "Dubai", "дубае": "Dubai",
+}
+
+// lookupPlace resolves a lowercase place name
+func lookupPlace(name string) (Place, bool) {
+	target, ok := placeNames[name]
+	if !ok {
+		return Place{}, false
+	}
+	if target == "EU" || target == "Europe" {
+		return Place{Name: target, Region: target}, true
+	}
+	place := countries[target]
+	place.Country = target
+	place.Name = target
+	if city, ok := cityNames[name]; ok {
+		place.Name = city
+	}
+	return place, true
+}
+
+// findPlaces finds gazetteer places in a sentence's words, preferring the longest name.
+// Two-letter codes ("us", "uk", "eu") only count when written in capitals.
+func findPlaces(words, original []string) []Place {
+	var places []Place
+	for i := 0; i < len(words); {
+		matched := false
+		for n := maxPlaceWords; n >= 1; n-- {
+			if i+n > len(words) {
+				continue
+			}
+			name := strings.Join(words[i:i+n], " ")
+			if n == 1 && utf8.RuneCountInString(name) <= 2 && original[i] != strings.ToUpper(original[i]) {
+				continue
+			}
+			if place, ok := lookupPlace(name); ok {
+				places = append(places, place)
+				i += n
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	return places
+}
+
+// within reports whether place p lies in place area (same city, same country, or inside a region)
+func (p Place) within(area Place) bool {
+	switch {
+	case area.Country == "":
+		// "Europe" includes the EU
+		return p.Region == area.Region || area.Region == "Europe" && p.Region == "EU" || p.Name == area.Name
+	case area.Name != area.Country:
+		return p.Name == area.Name
+	default:
+		return p.Country == area.Country
+	}
+}
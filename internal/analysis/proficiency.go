@@ -0,0 +1,173 @@
+package analysis
+
+import "strings"
+
+// Proficiency levels, from lowest to highest
+const (
+	ProficiencyBasic        = "basic"
+	ProficiencyIntermediate = "intermediate"
+	ProficiencyAdvanced     = "advanced"
+	ProficiencyExpert       = "expert"
+)
+
+// proficiencyRank orders proficiency levels; unknown levels rank 0
+var proficiencyRank = map[string]int{
+	ProficiencyBasic:        1,
+	ProficiencyIntermediate: 2,
+	ProficiencyAdvanced:     3,
+	ProficiencyExpert:       4,
+}
+
+// proficiencyBeforeWindow and proficiencyAfterWindow bound how far a cue may be from its skill
+const (
+	proficiencyBeforeWindow = 4
+	proficiencyAfterWindow  = 2
+)
+
+// proficiencyCues maps English, Russian and CEFR cue phrases to proficiency levels
+var proficiencyCues = map[string]string{
+	"basic knowledge": ProficiencyBasic, "basic understanding": ProficiencyBasic, "basic": ProficiencyBasic,
+	"familiar with": ProficiencyBasic, "familiarity with": ProficiencyBasic, "exposure to": ProficiencyBasic,
+	"beginner": ProficiencyBasic, "elementary": ProficiencyBasic, "a1": ProficiencyBasic, "a2": ProficiencyBasic,
+	"базовые знания": ProficiencyBasic, "базовое знание": ProficiencyBasic, "базовый": ProficiencyBasic,
+	"начальный": ProficiencyBasic, "знакомство с": ProficiencyBasic,
+
+	"intermediate": ProficiencyIntermediate, "working knowledge": ProficiencyIntermediate,
+	"good knowledge": ProficiencyIntermediate, "b1": ProficiencyIntermediate, "b2": ProficiencyIntermediate,
+	"средний": ProficiencyIntermediate, "хорошее знание": ProficiencyIntermediate, "хорошие знания": ProficiencyIntermediate,
+
+	"advanced": ProficiencyAdvanced, "strong": ProficiencyAdvanced, "deep knowledge": ProficiencyAdvanced,
+	"in-depth knowledge": ProficiencyAdvanced, "proficient in": ProficiencyAdvanced, "fluent": ProficiencyAdvanced,
+	"fluent in": ProficiencyAdvanced, "c1": ProficiencyAdvanced,
+	"глубокие знания": ProficiencyAdvanced, "глубокое знание": ProficiencyAdvanced, "уверенное": ProficiencyAdvanced,
+	"свободно": ProficiencyAdvanced, "свободный": ProficiencyAdvanced, "продвинутый": ProficiencyAdvanced,
+	"отличное знание": ProficiencyAdvanced,
+
+	"expert": ProficiencyExpert, "expert in": ProficiencyExpert, "expertise in": ProficiencyExpert,
+	"mastery of": ProficiencyExpert, "native": ProficiencyExpert, "c2": ProficiencyExpert,
+	"эксперт": ProficiencyExpert, "экспертные знания": ProficiencyExpert, "родной": ProficiencyExpert,
+	"носитель": ProficiencyExpert,
+}
+
+// maxProficiencyCueWords is the longest cue phrase in words
+const maxProficiencyCueWords = 2
+
+// proficiencyCue is a cue phrase found in a sentence, covering words[Start:End]
+type proficiencyCue struct {
+	Level      string
+	Start, End int
+}
+
+// findProficiencyCues finds cue phrases in a sentence's words, preferring the longest phrase.
+// A cue word that completes a dictionary name with the word before it ("React Native") is not a cue.
+func findProficiencyCues(words []string, isSkill func(string) bool) []proficiencyCue {
+	var cues []proficiencyCue
+	for i := 0; i < len(words); {
+		if i > 0 && isSkill(words[i-1]+" "+words[i]) {
+			i++
+			continue
+		}
+		matched := false
+		for n := maxProficiencyCueWords; n >= 1; n-- {
+			if i+n > len(words) {
+				continue
+			}
+			if level, ok := proficiencyCues[strings.Join(words[i:i+n], " ")]; ok {
+				cues = append(cues, proficiencyCue{Level: level, Start: i, End: i + n})
+				i += n
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	return cues
+}
+
+// proficiencyNear returns the level of the closest cue before ("expert in Go") or right after
+// ("Python (advanced)", "English — C1") words[i], without crossing a contrast word
+func proficiencyNear(words []string, cues []proficiencyCue, i int) string {
+	level, best := "", proficiencyBeforeWindow+1
+	for _, cue := range cues {
+		var distance int
+		var from, to int
+		switch {
+		case cue.End <= i:
+			distance, from, to = i-cue.End+1, cue.End, i
+		case cue.Start > i:
+			if cue.Start-i > proficiencyAfterWindow {
+				continue
+			}
+			distance, from, to = cue.Start-i, i+1, cue.Start
+		default:
+			continue
+		}
+		if distance > proficiencyBeforeWindow || distance >= best || crossesContrast(words, from, to) {
+			continue
+		}
+		level, best = cue.Level, distance
+	}
+	return level
+}
+
+// crossesContrast reports whether words[from:to] contains a contrast word
+func crossesContrast(words []string, from, to int) bool {
+	for k := from; k < to; k++ {
+		if contrastWords[words[k]] {
+			return true
+		}
+	}
+	return false
+}
+
+// mentionsProficiency returns the highest proficiency among affirmative mentions
+func mentionsProficiency(mentions []skillMention) string {
+	level := ""
+	for _, m := range mentions {
+		if !m.Negated && proficiencyRank[m.Proficiency] > proficiencyRank[level] {
+			level = m.Proficiency
+		}
+	}
+	return level
+}
+
+// proficiencyScore scores a matched skill's claimed proficiency against the JD requirement
+func proficiencyScore(cvSkill, jdSkill Skill) float64 {
+	claimed, required := proficiencyRank[cvSkill.Proficiency], proficiencyRank[jdSkill.Proficiency]
+	switch {
+	case required == 0 && claimed > 0:
+		return 0.8 // JD doesn't specify a level, CV states one
+	case required == 0 || claimed == 0:
+		return 0.5 // Nothing to compare
+	case claimed >= required:
+		return 1.0
+	default:
+		return float64(claimed) / float64(required)
+	}
+}
+
+// CalculateProficiencyAlignment computes how well claimed CV proficiency levels meet the
+// levels the JD asks for ("deep knowledge of Go" vs "basic knowledge of Go")
+func CalculateProficiencyAlignment(cvSkills, jdSkills []Skill) float64 {
+	if len(jdSkills) == 0 {
+		return 0.0
+	}
+
+	matches, _, _ := MatchSkills(cvSkills, jdSkills)
+	if len(matches) == 0 {
+		return 0.0
+	}
+
+	jdIndex := make(map[string]Skill, len(jdSkills))
+	for _, skill := range jdSkills {
+		jdIndex[skill.Name] = skill
+	}
+
+	total := 0.0
+	for _, skill := range matches {
+		total += proficiencyScore(skill, jdIndex[skill.Name])
+	}
+	return total / float64(len(matches))
+}
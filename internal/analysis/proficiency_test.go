@@ -0,0 +1,111 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExtractSkills_Proficiency(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	tests := []struct {
+		content     string
+		skill       string
+		proficiency string
+	}{
+		{"Expert in Go and Python", "go", ProficiencyExpert},
+		{"Expert in Go and Python", "python", ProficiencyExpert},
+		{"Basic knowledge of Docker", "docker", ProficiencyBasic},
+		{"Familiarity with Kubernetes is a plus", "kubernetes", ProficiencyBasic},
+		{"Deep knowledge of Go internals", "go", ProficiencyAdvanced},
+		{"Python (advanced)", "python", ProficiencyAdvanced},
+		{"Java — C1", "java", ProficiencyAdvanced},
+		{"Свободно владею Python", "python", ProficiencyAdvanced},
+		{"Strong Java but basic Python", "java", ProficiencyAdvanced},
+		{"Strong Java but basic Python", "python", ProficiencyBasic},
+		{"Basic Docker. Expert in Docker", "docker", ProficiencyExpert},
+		{"React Native mobile apps", "react", ""},
+		{"Built services in Java", "java", ""},
+	}
+
+	for _, tt := range tests {
+		var found *Skill
+		for _, skill := range ExtractSkills(ctx, tt.content, sd) {
+			if skill.Name == tt.skill {
+				found = &skill
+				break
+			}
+		}
+		if found == nil {
+			t.Errorf("Skill %q not found in %q", tt.skill, tt.content)
+			continue
+		}
+		if found.Proficiency != tt.proficiency {
+			t.Errorf("For %q, expected %s proficiency %q, got %q", tt.content, tt.skill, tt.proficiency, found.Proficiency)
+		}
+	}
+}
+
+func TestFindProficiencyCues(t *testing.T) {
+	noSkills := func(string) bool { return false }
+	cues := findProficiencyCues([]string{"basic", "knowledge", "of", "go", "b2"}, noSkills)
+
+	if len(cues) != 2 {
+		t.Fatalf("Expected two cues, got %+v", cues)
+	}
+	if cues[0] != (proficiencyCue{Level: ProficiencyBasic, Start: 0, End: 2}) {
+		t.Errorf("Expected the two-word cue to win, got %+v", cues[0])
+	}
+	if cues[1] != (proficiencyCue{Level: ProficiencyIntermediate, Start: 4, End: 5}) {
+		t.Errorf("Unexpected CEFR cue: %+v", cues[1])
+	}
+}
+
+func TestProficiencyScore(t *testing.T) {
+	tests := []struct {
+		claimed  string
+		required string
+		expected float64
+	}{
+		{ProficiencyExpert, ProficiencyAdvanced, 1.0},
+		{ProficiencyAdvanced, ProficiencyAdvanced, 1.0},
+		{ProficiencyBasic, ProficiencyAdvanced, 1.0 / 3.0},
+		{ProficiencyIntermediate, ProficiencyExpert, 0.5},
+		{ProficiencyAdvanced, "", 0.8},
+		{"", ProficiencyAdvanced, 0.5},
+		{"", "", 0.5},
+	}
+
+	for _, tt := range tests {
+		score := proficiencyScore(Skill{Proficiency: tt.claimed}, Skill{Proficiency: tt.required})
+		if !almostEqual(score, tt.expected, 0.001) {
+			t.Errorf("proficiencyScore(%q, %q) = %f, want %f", tt.claimed, tt.required, score, tt.expected)
+		}
+	}
+}
+
+func TestCalculateProficiencyAlignment(t *testing.T) {
+	jdSkills := []Skill{{Name: "go", Proficiency: ProficiencyAdvanced}, {Name: "docker", Proficiency: ProficiencyBasic}}
+
+	aligned := CalculateProficiencyAlignment([]Skill{
+		{Name: "go", Proficiency: ProficiencyExpert},
+		{Name: "docker", Proficiency: ProficiencyIntermediate},
+	}, jdSkills)
+	if aligned != 1.0 {
+		t.Errorf("Expected full alignment, got %f", aligned)
+	}
+
+	// Basic Go (1/3) against advanced, Docker unstated (0.5)
+	partial := CalculateProficiencyAlignment([]Skill{
+		{Name: "go", Proficiency: ProficiencyBasic},
+		{Name: "docker"},
+	}, jdSkills)
+	if !almostEqual(partial, (1.0/3.0+0.5)/2, 0.001) {
+		t.Errorf("Expected partial alignment, got %f", partial)
+	}
+
+	if none := CalculateProficiencyAlignment([]Skill{{Name: "java"}}, jdSkills); none != 0.0 {
+		t.Errorf("Expected zero alignment without matched skills, got %f", none)
+	}
+}
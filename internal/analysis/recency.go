@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"math"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// DefaultRecencyHalfLife is the number of years after which an unused skill counts half
+const DefaultRecencyHalfLife = 5.0
+
+// SkillRecency reports when a CV skill was last used in a dated role
+type SkillRecency struct {
+	Skill    string  `json:"skill"`
+	LastUsed int     `json:"last_used,omitempty"` // Year of the latest role mentioning the skill (0 if unknown)
+	Recency  float64 `json:"recency"`             // 0.0-1.0 discount applied to the skill
+}
+
+// ApplySkillRecency sets LastUsed and Recency on CV skills from the role date ranges in the CV.
+// A skill's last used year is the end of the latest dated role that mentions it; the recency factor
+// halves every halfLife years since then. Skills not tied to any dated role keep full weight,
+// and a halfLife <= 0 disables discounting.
+func ApplySkillRecency(skills []Skill, cv *parse.CV, currentYear int, halfLife float64) []Skill {
+	lastUsed := make(map[string]int)
+	for _, role := range cv.Experience {
+		year := role.Dates.LastYear(currentYear)
+		if year == 0 {
+			continue
+		}
+		for _, word := range tokenizeContent(roleText(role)) {
+			if year > lastUsed[word] {
+				lastUsed[word] = year
+			}
+		}
+	}
+
+	for i := range skills {
+		year, ok := lastUsed[skills[i].Name]
+		if !ok {
+			skills[i].Recency = 1.0
+			continue
+		}
+		skills[i].LastUsed = year
+		skills[i].Recency = recencyFactor(currentYear-year, halfLife)
+	}
+	return skills
+}
+
+// recencyFactor returns 0.5^(age/halfLife), or 1.0 when discounting is disabled
+func recencyFactor(age int, halfLife float64) float64 {
+	if halfLife <= 0 || age <= 0 {
+		return 1.0
+	}
+	return math.Pow(0.5, float64(age)/halfLife)
+}
+
+// roleText joins everything a role says about the work done
+func roleText(role parse.ExperienceEntry) string {
+	text := role.Title + "\n" + role.Company
+	for _, bullet := range role.Bullets {
+		text += "\n" + bullet
+	}
+	for _, tech := range role.Technologies {
+		text += "\n" + tech
+	}
+	return text
+}
+
+// recencyWeight returns the skill's recency discount, treating an unset recency as current
+func (s Skill) recencyWeight() float64 {
+	if s.Recency == 0 {
+		return 1.0
+	}
+	return s.Recency
+}
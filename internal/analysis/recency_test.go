@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+const recencyCV = `# Jane Doe
+
+## Experience
+### Senior Engineer @ Acme (2021 - Present)
+- Built payment services in Go
+- Tech: Go, Kubernetes
+
+### Developer @ Initech (2010 - 2012)
+- Maintained a Perl and PHP monolith
+
+## Skills
+Go, PHP, Docker
+`
+
+func TestApplySkillRecency(t *testing.T) {
+	skills := []Skill{{Name: "go"}, {Name: "php"}, {Name: "kubernetes"}, {Name: "docker"}}
+	skills = ApplySkillRecency(skills, parse.ParseCV(recencyCV), 2025, 5)
+
+	tests := []struct {
+		name     string
+		lastUsed int
+		recency  float64
+	}{
+		{"go", 2025, 1.0},
+		{"php", 2012, math.Pow(0.5, 13.0/5)},
+		{"kubernetes", 2025, 1.0},
+		{"docker", 0, 1.0}, // Only in the skills section
+	}
+
+	for i, tt := range tests {
+		if skills[i].Name != tt.name {
+			t.Fatalf("skill %d = %q, want %q", i, skills[i].Name, tt.name)
+		}
+		if skills[i].LastUsed != tt.lastUsed {
+			t.Errorf("%s: LastUsed = %d, want %d", tt.name, skills[i].LastUsed, tt.lastUsed)
+		}
+		if math.Abs(skills[i].Recency-tt.recency) > 0.001 {
+			t.Errorf("%s: Recency = %f, want %f", tt.name, skills[i].Recency, tt.recency)
+		}
+	}
+}
+
+func TestApplySkillRecency_Disabled(t *testing.T) {
+	skills := ApplySkillRecency([]Skill{{Name: "php"}}, parse.ParseCV(recencyCV), 2025, 0)
+	if skills[0].LastUsed != 2012 || skills[0].Recency != 1.0 {
+		t.Errorf("Expected last used 2012 without discount, got %+v", skills[0])
+	}
+}
+
+func TestCalculateSkillCoverage_Recency(t *testing.T) {
+	cvSkills := []Skill{{Name: "go", Recency: 1.0}, {Name: "php", Recency: 0.5}}
+	jdSkills := []Skill{{Name: "go"}, {Name: "php"}}
+
+	if result := CalculateSkillCoverage(cvSkills, jdSkills); math.Abs(result-0.75) > 0.001 {
+		t.Errorf("Expected coverage 0.75 with a stale skill, got %f", result)
+	}
+	if result := CalculateExperienceMatch(cvSkills, jdSkills); math.Abs(result-(0.5+0.25)/2) > 0.001 {
+		t.Errorf("Expected experience match 0.375 with a stale skill, got %f", result)
+	}
+}
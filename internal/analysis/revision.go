@@ -0,0 +1,186 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Section diff statuses
+const (
+	SectionAdded   = "added"
+	SectionRemoved = "removed"
+	SectionChanged = "changed"
+)
+
+// SectionDiff lists the lines added to and removed from one CV section between two revisions
+type SectionDiff struct {
+	Section string   `json:"section"` // Section kind, or the heading of an unrecognized section
+	Status  string   `json:"status"`  // SectionAdded, SectionRemoved or SectionChanged
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// CVRevisionDiff compares two revisions of a CV against the same JD
+type CVRevisionDiff struct {
+	Sections      []SectionDiff `json:"sections"`
+	SkillsAdded   []string      `json:"skills_added"`
+	SkillsRemoved []string      `json:"skills_removed"`
+	// UnsupportedSkills are added skills the new revision only lists, without mentioning them
+	// in experience or projects: a sign of keyword stuffing
+	UnsupportedSkills []string        `json:"unsupported_skills,omitempty"`
+	OldScore          *ScoreBreakdown `json:"old_score"`
+	NewScore          *ScoreBreakdown `json:"new_score"`
+	ScoreDelta        ScoreBreakdown  `json:"score_delta"` // New minus old, per component
+}
+
+// CompareRevisions analyzes two revisions of a CV against a JD and reports what changed
+func (e *AnalysisEngine) CompareRevisions(ctx context.Context, oldCV, newCV, jdContent string) (*CVRevisionDiff, error) {
+	oldResult, err := e.Analyze(ctx, oldCV, jdContent)
+	if err != nil {
+		return nil, fmt.Errorf("analyze old revision: %w", err)
+	}
+	newResult, err := e.Analyze(ctx, newCV, jdContent)
+	if err != nil {
+		return nil, fmt.Errorf("analyze new revision: %w", err)
+	}
+
+	dict := NewSkillsDictionary()
+	added, removed := DiffSkills(ExtractSkills(ctx, oldCV, dict), ExtractSkills(ctx, newCV, dict))
+
+	diff := &CVRevisionDiff{
+		Sections:          DiffCVSections(oldCV, newCV),
+		SkillsAdded:       added,
+		SkillsRemoved:     removed,
+		UnsupportedSkills: unsupportedSkills(added, parse.ParseCV(newCV)),
+		OldScore:          oldResult.ScoringBreakdown,
+		NewScore:          newResult.ScoringBreakdown,
+	}
+	if diff.OldScore != nil && diff.NewScore != nil {
+		diff.ScoreDelta = ScoreDelta(diff.OldScore, diff.NewScore)
+	}
+	return diff, nil
+}
+
+// ScoreDelta returns the change of every score component from before to after
+func ScoreDelta(before, after *ScoreBreakdown) ScoreBreakdown {
+	return ScoreBreakdown{
+		SkillCoverage:  after.SkillCoverage - before.SkillCoverage,
+		Experience:     after.Experience - before.Experience,
+		TermSimilarity: after.TermSimilarity - before.TermSimilarity,
+		OverallMatch:   after.OverallMatch - before.OverallMatch,
+		Proficiency:    after.Proficiency - before.Proficiency,
+		WeightedTotal:  after.WeightedTotal - before.WeightedTotal,
+	}
+}
+
+// DiffCVSections compares two CV revisions section by section. Sections are matched by kind
+// (or heading when unrecognized); lines are compared after trimming, ignoring reordering.
+func DiffCVSections(oldContent, newContent string) []SectionDiff {
+	oldSections, oldOrder := sectionLines(oldContent)
+	newSections, newOrder := sectionLines(newContent)
+
+	var diffs []SectionDiff
+	for _, key := range newOrder {
+		oldLines, existed := oldSections[key]
+		added, removed := diffLines(oldLines, newSections[key])
+		switch {
+		case !existed:
+			diffs = append(diffs, SectionDiff{Section: key, Status: SectionAdded, Added: added})
+		case len(added) > 0 || len(removed) > 0:
+			diffs = append(diffs, SectionDiff{Section: key, Status: SectionChanged, Added: added, Removed: removed})
+		}
+	}
+	for _, key := range oldOrder {
+		if _, kept := newSections[key]; !kept {
+			diffs = append(diffs, SectionDiff{Section: key, Status: SectionRemoved, Removed: oldSections[key]})
+		}
+	}
+	return diffs
+}
+
+// sectionLines returns the non-empty lines of each CV section, keyed by section, in document order
+func sectionLines(content string) (map[string][]string, []string) {
+	sections := make(map[string][]string)
+	var order []string
+	for _, section := range parse.ParseCV(content).Sections {
+		key := string(section.Kind)
+		if section.Kind == parse.SectionUnknown {
+			key = strings.ToLower(strings.TrimSpace(section.Heading))
+		}
+		if _, seen := sections[key]; !seen {
+			order = append(order, key)
+			sections[key] = nil
+		}
+		for _, line := range strings.Split(section.Content, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				sections[key] = append(sections[key], line)
+			}
+		}
+	}
+	return sections, order
+}
+
+// diffLines returns the lines only in newLines and only in oldLines, counting repeats
+func diffLines(oldLines, newLines []string) (added, removed []string) {
+	remaining := make(map[string]int, len(oldLines))
+	for _, line := range oldLines {
+		remaining[line]++
+	}
+	for _, line := range newLines {
+		if remaining[line] > 0 {
+			remaining[line]--
+			continue
+		}
+		added = append(added, line)
+	}
+	for _, line := range oldLines {
+		if remaining[line] > 0 {
+			remaining[line]--
+			removed = append(removed, line)
+		}
+	}
+	return added, removed
+}
+
+// DiffSkills returns the affirmative skills only the new revision has, and those only the old one has
+func DiffSkills(oldSkills, newSkills []Skill) (added, removed []string) {
+	oldNames, newNames := affirmedSkills(oldSkills), affirmedSkills(newSkills)
+	added, removed = []string{}, []string{}
+	for _, skill := range newSkills {
+		if newNames[skill.Name] && !oldNames[skill.Name] {
+			added = append(added, skill.Name)
+		}
+	}
+	for _, skill := range oldSkills {
+		if oldNames[skill.Name] && !newNames[skill.Name] {
+			removed = append(removed, skill.Name)
+		}
+	}
+	return added, removed
+}
+
+// affirmedSkills returns the names of skills not only mentioned in negated form
+func affirmedSkills(skills []Skill) map[string]bool {
+	names := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		if !skill.Negated {
+			names[skill.Name] = true
+		}
+	}
+	return names
+}
+
+// unsupportedSkills returns the skills not mentioned in a CV's experience or projects
+func unsupportedSkills(skills []string, cv *parse.CV) []string {
+	evidence := cv.SectionText(parse.SectionExperience) + "\n" + cv.SectionText(parse.SectionProjects)
+	var unsupported []string
+	for _, skill := range skills {
+		if !mentionsWord(evidence, skill) {
+			unsupported = append(unsupported, skill)
+		}
+	}
+	return unsupported
+}
@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+const cvRevisionOld = `# Jane Doe
+
+## Summary
+Backend developer.
+
+## Experience
+### Developer - Acme
+2020 - Present
+- Built REST APIs in Go
+- Maintained PostgreSQL databases
+
+## Skills
+Go, PostgreSQL
+`
+
+const cvRevisionNew = `# Jane Doe
+
+## Summary
+Backend developer.
+
+## Experience
+### Developer - Acme
+2020 - Present
+- Built REST APIs in Go
+- Deployed services to Kubernetes
+
+## Skills
+Go, PostgreSQL, Kubernetes, Terraform, Kafka
+
+## Certifications
+- CKA
+`
+
+func TestDiffCVSections(t *testing.T) {
+	diffs := DiffCVSections(cvRevisionOld, cvRevisionNew)
+
+	want := []SectionDiff{
+		{Section: "experience", Status: SectionChanged, Added: []string{"- Deployed services to Kubernetes"}, Removed: []string{"- Maintained PostgreSQL databases"}},
+		{Section: "skills", Status: SectionChanged, Added: []string{"Go, PostgreSQL, Kubernetes, Terraform, Kafka"}, Removed: []string{"Go, PostgreSQL"}},
+		{Section: "certifications", Status: SectionAdded, Added: []string{"- CKA"}},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("Expected %+v, got %+v", want, diffs)
+	}
+
+	if diffs := DiffCVSections(cvRevisionOld, cvRevisionOld); len(diffs) != 0 {
+		t.Errorf("Expected no differences between identical revisions, got %+v", diffs)
+	}
+}
+
+func TestDiffSkills(t *testing.T) {
+	oldSkills := []Skill{{Name: "Go"}, {Name: "Java"}, {Name: "Rust", Negated: true}}
+	newSkills := []Skill{{Name: "Go"}, {Name: "Rust"}, {Name: "Python"}}
+
+	added, removed := DiffSkills(oldSkills, newSkills)
+	if !reflect.DeepEqual(added, []string{"Rust", "Python"}) {
+		t.Errorf("Expected Rust and Python added, got %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"Java"}) {
+		t.Errorf("Expected Java removed, got %v", removed)
+	}
+}
+
+func TestEngine_CompareRevisions(t *testing.T) {
+	engine := NewAnalysisEngine()
+	jd := "# Platform Engineer\n\n## Requirements\n- Go\n- Kubernetes\n- Terraform\n- Kafka\n"
+
+	diff, err := engine.CompareRevisions(context.Background(), cvRevisionOld, cvRevisionNew, jd)
+	if err != nil {
+		t.Fatalf("CompareRevisions failed: %v", err)
+	}
+
+	for _, skill := range []string{"kubernetes", "terraform", "kafka"} {
+		if !containsString(diff.SkillsAdded, skill) {
+			t.Errorf("Expected %s among added skills, got %v", skill, diff.SkillsAdded)
+		}
+	}
+	if containsString(diff.UnsupportedSkills, "kubernetes") || !containsString(diff.UnsupportedSkills, "terraform") {
+		t.Errorf("Expected Terraform but not Kubernetes to be unsupported, got %v", diff.UnsupportedSkills)
+	}
+	if diff.OldScore == nil || diff.NewScore == nil {
+		t.Fatal("Expected scores for both revisions")
+	}
+	if diff.ScoreDelta.SkillCoverage <= 0 || diff.ScoreDelta.WeightedTotal != diff.NewScore.WeightedTotal-diff.OldScore.WeightedTotal {
+		t.Errorf("Expected a positive skill coverage delta, got %+v", diff.ScoreDelta)
+	}
+}
@@ -0,0 +1,546 @@
+package analysis
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Screening rule kinds
+const (
+	RuleSkill    = "skill"
+	RuleMinYears = "min_years"
+	RuleLanguage = "language"
+	RuleLocation = "location"
+	RuleDegree   = "degree"
+)
+
+// Degree levels, from lowest to highest
+const (
+	DegreeAssociate = "associate"
+	DegreeBachelor  = "bachelor"
+	DegreeMaster    = "master"
+	DegreeDoctorate = "doctorate"
+)
+
+// degreeRank orders degree levels; unknown levels rank 0
+var degreeRank = map[string]int{DegreeAssociate: 1, DegreeBachelor: 2, DegreeMaster: 3, DegreeDoctorate: 4}
+
+// degreePatterns recognize degree levels in English and Russian, highest first.
+// A Russian "специалист" (five-year diploma) counts as a master's degree.
+var degreePatterns = []struct {
+	Level   string
+	Pattern *regexp.Regexp
+}{
+	{DegreeDoctorate, regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:ph\.?\s?d|doctor|doctorate|кандидат\s+\p{L}+\s+наук|доктор\s+\p{L}+\s+наук|кандидат\s+наук|доктор\s+наук)`)},
+	{DegreeMaster, regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:m\.?sc?|m\.?a|m\.?eng|mba|master|magister|магистр|специалист)(?:[^\p{L}]|$|'s|s\b)`)},
+	{DegreeBachelor, regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:b\.?sc?|b\.?a|b\.?eng|b\.?tech|bachelor|бакалавр)(?:[^\p{L}]|$|'s|s\b)`)},
+	{DegreeAssociate, regexp.MustCompile(`(?i)(?:^|[^\p{L}])associate(?:'s)?\s+(?:degree|of)`)},
+}
+
+var (
+	// ruleKinds maps the keywords of the rule expression language to rule kinds
+	ruleKinds = map[string]string{
+		"skill": RuleSkill, "skills": RuleSkill, "required_skills": RuleSkill,
+		"years": RuleMinYears, "min_years": RuleMinYears, "experience": RuleMinYears,
+		"language": RuleLanguage, "languages": RuleLanguage,
+		"location": RuleLocation, "locations": RuleLocation,
+		"degree": RuleDegree, "education": RuleDegree,
+	}
+	// ruleOperatorPattern matches the operator between a rule keyword and its values
+	ruleOperatorPattern = regexp.MustCompile(`^(?:>=|=>|≥|==|=|:|in\b)\s*`)
+	// ruleCommentPattern matches a trailing comment
+	ruleCommentPattern = regexp.MustCompile(`(?:^|\s)#.*$`)
+	// ruleListSeparator splits "Go, Kubernetes" and "EU or Serbia"
+	ruleListSeparator = regexp.MustCompile(`\s*(?:,|;|\bor\b|\band\b)\s*`)
+)
+
+// Rule is one screening rule an operator defines for a JD
+type Rule struct {
+	Kind   string   `json:"kind"`
+	Values []string `json:"values,omitempty"` // Skills, the language, or the allowed places
+	Level  string   `json:"level,omitempty"`  // CEFR level for language rules, degree level for degree rules
+	Years  int      `json:"years,omitempty"`  // Minimum years for min_years rules
+}
+
+// RuleResult is the outcome of one screening rule for a CV
+type RuleResult struct {
+	Rule   string `json:"rule"` // The rule in expression form, e.g. "years >= 5"
+	Kind   string `json:"kind"`
+	Status string `json:"status"` // CheckPass, CheckFail or CheckReview
+	Reason string `json:"reason"`
+}
+
+// CandidateProfile is the CV data screening rules are evaluated against
+type CandidateProfile struct {
+	Skills       []Skill
+	Years        int // Total years of professional experience, 0 when unknown
+	Languages    []LanguageSkill
+	Location     *Place
+	Relocation   string
+	AuthorizedIn []Place
+	Degree       string // Highest degree level, "" when none is recognized
+	Content      string
+}
+
+// String returns the rule in expression form
+func (r Rule) String() string {
+	switch r.Kind {
+	case RuleSkill:
+		return "skill " + strings.Join(r.Values, ", ")
+	case RuleMinYears:
+		return fmt.Sprintf("years >= %d", r.Years)
+	case RuleLanguage:
+		if r.Level == "" {
+			return "language " + r.Values[0]
+		}
+		return fmt.Sprintf("language %s >= %s", r.Values[0], r.Level)
+	case RuleLocation:
+		return "location in " + strings.Join(r.Values, ", ")
+	case RuleDegree:
+		return "degree >= " + r.Level
+	default:
+		return r.Kind
+	}
+}
+
+// ruleSpec is the YAML form of a JD's screening rules
+type ruleSpec struct {
+	RequiredSkills ruleList          `yaml:"required_skills"`
+	Skills         ruleList          `yaml:"skills"`
+	MinYears       *int              `yaml:"min_years"`
+	Languages      map[string]string `yaml:"languages"`
+	Location       ruleList          `yaml:"location"`
+	Degree         string            `yaml:"degree"`
+}
+
+// ruleList accepts a YAML sequence or a comma-separated scalar
+type ruleList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (l *ruleList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*l = splitRuleList(node.Value)
+		return nil
+	}
+	var values []string
+	if err := node.Decode(&values); err != nil {
+		return err
+	}
+	*l = values
+	return nil
+}
+
+// ParseRules parses screening rules written as YAML:
+//
+//	required_skills: [Go, Kubernetes]
+//	min_years: 5
+//	languages: {English: B2}
+//	location: [EU, Serbia]
+//	degree: bachelor
+//
+// or in the expression language, one rule per line or separated by semicolons:
+//
+//	skill Go, Kubernetes
+//	years >= 5
+//	language English >= B2
+//	location in EU, Serbia
+//	degree >= bachelor
+func ParseRules(text string) ([]Rule, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	// Semicolons only separate expressions; "skills: Go; years >= 5" would otherwise read as YAML
+	if !strings.Contains(text, ";") {
+		var spec ruleSpec
+		decoder := yaml.NewDecoder(bytes.NewBufferString(text))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&spec); err == nil {
+			return spec.rules()
+		}
+	}
+	return parseRuleExpressions(text)
+}
+
+// rules converts the YAML form to rules in a fixed order
+func (s ruleSpec) rules() ([]Rule, error) {
+	var rules []Rule
+	if skills := append(append([]string{}, s.RequiredSkills...), s.Skills...); len(skills) > 0 {
+		rules = append(rules, Rule{Kind: RuleSkill, Values: skills})
+	}
+	if s.MinYears != nil {
+		rules = append(rules, Rule{Kind: RuleMinYears, Years: *s.MinYears})
+	}
+	names := make([]string, 0, len(s.Languages))
+	for name := range s.Languages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rules = append(rules, Rule{Kind: RuleLanguage, Values: []string{name}, Level: s.Languages[name]})
+	}
+	if len(s.Location) > 0 {
+		rules = append(rules, Rule{Kind: RuleLocation, Values: s.Location})
+	}
+	if s.Degree != "" {
+		rules = append(rules, Rule{Kind: RuleDegree, Level: s.Degree})
+	}
+	return validateRules(rules)
+}
+
+// parseRuleExpressions parses the expression language. "#" after a space starts a comment ("C#" is a skill).
+func parseRuleExpressions(text string) ([]Rule, error) {
+	var rules []Rule
+	for _, line := range strings.Split(text, "\n") {
+		line = ruleCommentPattern.ReplaceAllString(line, "")
+		for _, expr := range strings.Split(line, ";") {
+			expr = strings.TrimSpace(expr)
+			if expr == "" {
+				continue
+			}
+			rule, err := parseRuleExpression(expr)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return validateRules(rules)
+}
+
+// parseRuleExpression parses one expression such as "language English >= B2"
+func parseRuleExpression(expr string) (Rule, error) {
+	keyword, rest, _ := strings.Cut(expr, " ")
+	keyword = strings.TrimSuffix(strings.ToLower(keyword), ":")
+	if k, v, found := strings.Cut(keyword, ":"); found {
+		// "years:5"
+		keyword, rest = k, v+" "+rest
+	}
+	kind, ok := ruleKinds[keyword]
+	if !ok {
+		return Rule{}, fmt.Errorf("unknown rule %q: expected skill, years, language, location or degree", expr)
+	}
+	rest = ruleOperatorPattern.ReplaceAllString(strings.TrimSpace(rest), "")
+	if rest == "" {
+		return Rule{}, fmt.Errorf("rule %q has no value", expr)
+	}
+
+	rule := Rule{Kind: kind}
+	switch kind {
+	case RuleSkill, RuleLocation:
+		rule.Values = splitRuleList(rest)
+	case RuleMinYears:
+		years, err := strconv.Atoi(strings.TrimRight(strings.Fields(rest)[0], "+"))
+		if err != nil {
+			return Rule{}, fmt.Errorf("rule %q: years must be a whole number", expr)
+		}
+		rule.Years = years
+	case RuleLanguage:
+		// "English >= B2", "English B2" or "English"
+		fields := strings.Fields(rest)
+		rule.Values = fields[:1]
+		if len(fields) > 1 {
+			rule.Level = ruleOperatorPattern.ReplaceAllString(strings.Join(fields[1:], " "), "")
+		}
+	case RuleDegree:
+		rule.Level = rest
+	}
+	return rule, nil
+}
+
+// splitRuleList splits a comma-separated list of values
+func splitRuleList(text string) []string {
+	var values []string
+	for _, value := range ruleListSeparator.Split(text, -1) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// validateRules normalizes rule values and rejects ones that can never be evaluated
+func validateRules(rules []Rule) ([]Rule, error) {
+	for i, rule := range rules {
+		switch rule.Kind {
+		case RuleSkill:
+			if len(rule.Values) == 0 {
+				return nil, fmt.Errorf("skill rule lists no skills")
+			}
+		case RuleMinYears:
+			if rule.Years < 0 {
+				return nil, fmt.Errorf("years must not be negative, got %d", rule.Years)
+			}
+		case RuleLanguage:
+			name := strings.TrimSpace(rule.Values[0])
+			canonical := languageName(strings.ToLower(name), strings.ToUpper(name))
+			if canonical == "" {
+				return nil, fmt.Errorf("unknown language %q", name)
+			}
+			level := NormalizeLanguageLevel(rule.Level)
+			if rule.Level != "" && level == "" {
+				return nil, fmt.Errorf("unknown level %q for %s: use a CEFR level such as B2", rule.Level, canonical)
+			}
+			rules[i].Values, rules[i].Level = []string{canonical}, level
+		case RuleLocation:
+			for _, value := range rule.Values {
+				if _, ok := lookupPlace(strings.ToLower(value)); !ok {
+					return nil, fmt.Errorf("unknown location %q", value)
+				}
+			}
+		case RuleDegree:
+			level := degreeLevel(rule.Level)
+			if level == "" {
+				if _, ok := degreeRank[strings.ToLower(strings.TrimSpace(rule.Level))]; ok {
+					level = strings.ToLower(strings.TrimSpace(rule.Level))
+				}
+			}
+			if level == "" {
+				return nil, fmt.Errorf("unknown degree %q: use associate, bachelor, master or doctorate", rule.Level)
+			}
+			rules[i].Level = level
+		}
+	}
+	return rules, nil
+}
+
+// degreeLevel returns the degree level named in text ("BSc Computer Science", "магистр"), or ""
+func degreeLevel(text string) string {
+	for _, degree := range degreePatterns {
+		if degree.Pattern.MatchString(text) {
+			return degree.Level
+		}
+	}
+	return ""
+}
+
+// highestDegree returns the highest degree level among a CV's education entries
+func highestDegree(cv *parse.CV) string {
+	highest := ""
+	for _, entry := range cv.Education {
+		text := strings.Join(append([]string{entry.Degree, entry.Institution}, entry.Details...), " ")
+		if level := degreeLevel(text); degreeRank[level] > degreeRank[highest] {
+			highest = level
+		}
+	}
+	return highest
+}
+
+// careerYears estimates total years of professional experience from the years covered by CV
+// roles (overlapping roles count once), or from a summary statement such as "7 years of experience"
+func careerYears(cv *parse.CV, currentYear int) int {
+	covered := make(map[int]bool)
+	for _, role := range cv.Experience {
+		if role.Dates.StartYear == 0 {
+			continue
+		}
+		end := role.Dates.LastYear(currentYear)
+		for year := role.Dates.StartYear; year < end; year++ {
+			covered[year] = true
+		}
+	}
+	years := len(covered)
+	if stated, ok := ParseExperience(cv.Headline + "\n" + cv.Summary); ok && stated.Min > years {
+		years = stated.Min
+	}
+	return years
+}
+
+// BuildCandidateProfile collects the CV data screening rules need
+func BuildCandidateProfile(content string, cv *parse.CV, skills []Skill, languages []LanguageSkill, constraints WorkConstraints, currentYear int) CandidateProfile {
+	return CandidateProfile{
+		Skills:       skills,
+		Years:        careerYears(cv, currentYear),
+		Languages:    languages,
+		Location:     constraints.Location,
+		Relocation:   constraints.Relocation,
+		AuthorizedIn: constraints.AuthorizedIn,
+		Degree:       highestDegree(cv),
+		Content:      content,
+	}
+}
+
+// EvaluateRules evaluates each screening rule against a candidate
+func EvaluateRules(rules []Rule, candidate CandidateProfile, dict *SkillsDictionary) []RuleResult {
+	results := make([]RuleResult, 0, len(rules))
+	for _, rule := range rules {
+		result := RuleResult{Rule: rule.String(), Kind: rule.Kind}
+		switch rule.Kind {
+		case RuleSkill:
+			result.Status, result.Reason = evaluateSkillRule(rule, candidate, dict)
+		case RuleMinYears:
+			result.Status, result.Reason = evaluateYearsRule(rule, candidate)
+		case RuleLanguage:
+			result.Status, result.Reason = evaluateLanguageRule(rule, candidate)
+		case RuleLocation:
+			result.Status, result.Reason = evaluateLocationRule(rule, candidate)
+		case RuleDegree:
+			result.Status, result.Reason = evaluateDegreeRule(rule, candidate)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// evaluateSkillRule passes when the CV lists every skill, under any alias ("k8s" for Kubernetes).
+// A skill outside the dictionary is never extracted, so a plain mention of it is flagged for
+// review instead of failing.
+func evaluateSkillRule(rule Rule, candidate CandidateProfile, dict *SkillsDictionary) (string, string) {
+	var missing, negated, unknown []string
+	for _, name := range rule.Values {
+		found := false
+		canonical := dict.Canonical(name)
+		for _, skill := range candidate.Skills {
+			if dict.Canonical(skill.Name) == canonical {
+				found = true
+				if skill.Negated {
+					negated = append(negated, name)
+				}
+				break
+			}
+		}
+		switch {
+		case found:
+		case !dictionaryHas(dict, name) && mentionsWord(candidate.Content, name):
+			unknown = append(unknown, name)
+		default:
+			missing = append(missing, name)
+		}
+	}
+
+	switch {
+	case len(missing) > 0:
+		return CheckFail, "missing " + strings.Join(missing, ", ")
+	case len(negated) > 0:
+		return CheckFail, "CV says no experience with " + strings.Join(negated, ", ")
+	case len(unknown) > 0:
+		return CheckReview, strings.Join(unknown, ", ") + " mentioned in CV but not in the skills dictionary"
+	default:
+		return CheckPass, "CV lists " + strings.Join(rule.Values, ", ")
+	}
+}
+
+// dictionaryHas reports whether the skills dictionary knows a skill name
+func dictionaryHas(dict *SkillsDictionary, name string) bool {
+	if dict == nil {
+		return false
+	}
+	_, found := dict.FindSkill(name)
+	return found
+}
+
+// mentionsWord reports whether text contains name as a whole word, ignoring case
+func mentionsWord(text, name string) bool {
+	pattern := `(?i)(?:^|[^\p{L}\p{N}])` + regexp.QuoteMeta(name) + `(?:[^\p{L}\p{N}]|$)`
+	matched, err := regexp.MatchString(pattern, text)
+	return err == nil && matched
+}
+
+// evaluateYearsRule compares total years of experience with the minimum
+func evaluateYearsRule(rule Rule, candidate CandidateProfile) (string, string) {
+	switch {
+	case candidate.Years == 0:
+		return CheckReview, "CV states no dated experience"
+	case candidate.Years >= rule.Years:
+		return CheckPass, fmt.Sprintf("%d years of experience", candidate.Years)
+	default:
+		return CheckFail, fmt.Sprintf("%d years of experience, below the required %d", candidate.Years, rule.Years)
+	}
+}
+
+// evaluateLanguageRule applies the same check as JD language requirements; a level the CV
+// doesn't state is left for review rather than failed
+func evaluateLanguageRule(rule Rule, candidate CandidateProfile) (string, string) {
+	match := MatchLanguages(candidate.Languages, []LanguageSkill{{Name: rule.Values[0], Level: rule.Level}})[0]
+	switch {
+	case match.Pass:
+		return CheckPass, match.Reason
+	case match.Claimed == "" && match.Reason != "not listed in CV":
+		return CheckReview, match.Reason
+	default:
+		return CheckFail, match.Reason
+	}
+}
+
+// evaluateLocationRule passes when the candidate is based or authorized to work in an allowed place
+func evaluateLocationRule(rule Rule, candidate CandidateProfile) (string, string) {
+	allowed := make([]Place, 0, len(rule.Values))
+	for _, value := range rule.Values {
+		place, _ := lookupPlace(strings.ToLower(value))
+		allowed = append(allowed, place)
+	}
+	for _, area := range allowed {
+		if candidate.Location != nil && candidate.Location.within(area) {
+			return CheckPass, fmt.Sprintf("CV is based in %s, within %s", candidate.Location.Name, area.Name)
+		}
+		for _, place := range candidate.AuthorizedIn {
+			if place.within(area) {
+				return CheckPass, "CV is authorized to work in " + area.Name
+			}
+		}
+	}
+
+	where := strings.Join(rule.Values, ", ")
+	switch {
+	case candidate.Location == nil:
+		return CheckReview, "CV states no location"
+	case candidate.Relocation == StanceYes:
+		return CheckReview, fmt.Sprintf("CV is based in %s, outside %s, but willing to relocate", candidate.Location.Name, where)
+	default:
+		return CheckFail, fmt.Sprintf("CV is based in %s, outside %s", candidate.Location.Name, where)
+	}
+}
+
+// evaluateDegreeRule compares the highest CV degree with the minimum level
+func evaluateDegreeRule(rule Rule, candidate CandidateProfile) (string, string) {
+	switch {
+	case candidate.Degree == "":
+		return CheckReview, "no degree recognized in CV education"
+	case degreeRank[candidate.Degree] >= degreeRank[rule.Level]:
+		return CheckPass, candidate.Degree + " degree meets " + rule.Level
+	default:
+		return CheckFail, candidate.Degree + " degree below required " + rule.Level
+	}
+}
+
+// ScreeningStatus combines rule results: fail if any rule fails, needs_review if any needs
+// review, otherwise pass. It returns "" when there are no results.
+func ScreeningStatus(results []RuleResult) string {
+	if len(results) == 0 {
+		return ""
+	}
+	status := CheckPass
+	for _, result := range results {
+		switch result.Status {
+		case CheckFail:
+			return CheckFail
+		case CheckReview:
+			status = CheckReview
+		}
+	}
+	return status
+}
+
+// RuleResultsWithStatus narrows the rule results a report lists to those with one of the given
+// statuses; no statuses keeps all. It does not screen candidates: ScreeningStatus still covers
+// every rule, so a candidate failing an unlisted rule remains failed.
+func RuleResultsWithStatus(results []RuleResult, statuses []string) []RuleResult {
+	if len(statuses) == 0 {
+		return results
+	}
+	filtered := make([]RuleResult, 0, len(results))
+	for _, result := range results {
+		if containsString(statuses, result.Status) {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
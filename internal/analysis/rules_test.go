@@ -0,0 +1,184 @@
+package analysis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+const screeningCV = `# Olga Ivanova
+Location: Belgrade, Serbia
+
+## Summary
+Backend engineer with 6 years of experience. Open to relocation.
+
+## Experience
+### Senior Go Developer - Acme
+2019 - Present
+- Built services in Go and PostgreSQL
+
+## Education
+### Belgrade University
+BSc Computer Science, 2014 - 2018
+
+## Languages
+- English: B2
+- Serbian: native
+`
+
+func TestParseRules(t *testing.T) {
+	want := []Rule{
+		{Kind: RuleSkill, Values: []string{"Go", "Kubernetes"}},
+		{Kind: RuleMinYears, Years: 5},
+		{Kind: RuleLanguage, Values: []string{"English"}, Level: "B2"},
+		{Kind: RuleLocation, Values: []string{"EU", "Serbia"}},
+		{Kind: RuleDegree, Level: DegreeBachelor},
+	}
+
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"yaml", "required_skills: [Go, Kubernetes]\nmin_years: 5\nlanguages:\n  English: B2\nlocation: [EU, Serbia]\ndegree: bachelor\n"},
+		{"expressions", "skill Go, Kubernetes\nyears >= 5 # total\nlanguage English >= B2\nlocation in EU or Serbia\ndegree >= BSc\n"},
+		{"semicolons", "skills: Go and Kubernetes; experience 5+; language English upper-intermediate; location EU, Serbia; education bachelor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRules(tt.text)
+			if err != nil {
+				t.Fatalf("ParseRules failed: %v", err)
+			}
+			if !reflect.DeepEqual(rules, want) {
+				t.Errorf("Expected %+v, got %+v", want, rules)
+			}
+		})
+	}
+}
+
+func TestParseRules_Invalid(t *testing.T) {
+	for _, text := range []string{
+		"salary >= 100",
+		"years >= five",
+		"language Klingon",
+		"language English >= fluentish",
+		"location in Atlantis",
+		"degree >= diploma-ish",
+		"skill",
+	} {
+		if _, err := ParseRules(text); err == nil {
+			t.Errorf("Expected an error for %q", text)
+		}
+	}
+
+	rules, err := ParseRules("  \n")
+	if err != nil || rules != nil {
+		t.Errorf("Expected no rules for empty text, got %v, %v", rules, err)
+	}
+}
+
+func TestDegreeLevel(t *testing.T) {
+	tests := map[string]string{
+		"BSc Computer Science":           DegreeBachelor,
+		"Bachelor's degree in Economics": DegreeBachelor,
+		"M.Sc. Software Engineering":     DegreeMaster,
+		"Магистр, прикладная математика": DegreeMaster,
+		"PhD in Physics":                 DegreeDoctorate,
+		"кандидат технических наук":      DegreeDoctorate,
+		"Associate degree in IT":         DegreeAssociate,
+		"Frontend Bootcamp":              "",
+	}
+	for text, want := range tests {
+		if got := degreeLevel(text); got != want {
+			t.Errorf("degreeLevel(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestEvaluateRules(t *testing.T) {
+	cv := parse.ParseCV(screeningCV)
+	skills := ExtractSkills(context.Background(), screeningCV, NewSkillsDictionary())
+	constraints := ExtractCVConstraints(screeningCV, cv)
+	candidate := BuildCandidateProfile(screeningCV, cv, skills, extractCVLanguages(screeningCV, cv), constraints, 2025)
+
+	if candidate.Degree != DegreeBachelor {
+		t.Errorf("Expected bachelor degree, got %q", candidate.Degree)
+	}
+	if candidate.Years != 6 {
+		t.Errorf("Expected 6 years of experience, got %d", candidate.Years)
+	}
+
+	tests := []struct {
+		rule   string
+		status string
+	}{
+		{"skill Go, PostgreSQL # core stack", CheckPass},
+		{"skill C#", CheckFail},
+		{"skill Go, Kubernetes", CheckFail},
+		{"skill golang, postgres", CheckPass}, // Aliases of the CV's Go and PostgreSQL
+		{"skill k8s", CheckFail},
+		{"years >= 5", CheckPass},
+		{"years >= 8", CheckFail},
+		{"language English >= B2", CheckPass},
+		{"language English >= C1", CheckFail},
+		{"language German", CheckFail},
+		{"location in Serbia", CheckPass},
+		{"location in EU", CheckReview}, // Outside, but open to relocation
+		{"degree >= bachelor", CheckPass},
+		{"degree >= master", CheckFail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			rules, err := ParseRules(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRules failed: %v", err)
+			}
+			results := EvaluateRules(rules, candidate, NewSkillsDictionary())
+			if len(results) != 1 || results[0].Status != tt.status || results[0].Reason == "" {
+				t.Errorf("Expected %s, got %+v", tt.status, results)
+			}
+		})
+	}
+}
+
+func TestEvaluateRules_MissingData(t *testing.T) {
+	rules, err := ParseRules("years >= 3; location in EU; degree >= bachelor; language English >= B2")
+	if err != nil {
+		t.Fatalf("ParseRules failed: %v", err)
+	}
+	candidate := CandidateProfile{Languages: []LanguageSkill{{Name: "English", Category: LanguageCategory}}}
+
+	for _, result := range EvaluateRules(rules, candidate, nil) {
+		if result.Status != CheckReview {
+			t.Errorf("Expected %q to need review without CV data, got %+v", result.Rule, result)
+		}
+	}
+}
+
+func TestScreeningStatus(t *testing.T) {
+	pass := RuleResult{Status: CheckPass}
+	review := RuleResult{Status: CheckReview}
+	fail := RuleResult{Status: CheckFail}
+
+	if got := ScreeningStatus(nil); got != "" {
+		t.Errorf("Expected no status without results, got %q", got)
+	}
+	if got := ScreeningStatus([]RuleResult{pass, pass}); got != CheckPass {
+		t.Errorf("Expected pass, got %q", got)
+	}
+	if got := ScreeningStatus([]RuleResult{pass, review}); got != CheckReview {
+		t.Errorf("Expected needs_review, got %q", got)
+	}
+	if got := ScreeningStatus([]RuleResult{review, fail, pass}); got != CheckFail {
+		t.Errorf("Expected fail, got %q", got)
+	}
+
+	filtered := RuleResultsWithStatus([]RuleResult{pass, review, fail}, []string{CheckFail, CheckReview})
+	if !reflect.DeepEqual(filtered, []RuleResult{review, fail}) {
+		t.Errorf("Expected review and fail results, got %+v", filtered)
+	}
+}
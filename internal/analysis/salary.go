@@ -0,0 +1,362 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Pay periods
+const (
+	PeriodHour  = "hour"
+	PeriodMonth = "month"
+	PeriodYear  = "year"
+)
+
+// How a CV expectation compares with a JD band
+const (
+	SalaryWithin  = "within"  // The whole expectation fits the band
+	SalaryOverlap = "overlap" // Part of the expectation fits the band
+	SalaryAbove   = "above"   // The expectation starts above the band
+	SalaryBelow   = "below"   // The expectation ends below the band
+	SalaryUnknown = "unknown" // A side is missing or cannot be converted
+)
+
+// Defaults for salary normalization
+const (
+	DefaultBaseCurrency = "USD"
+	// DefaultNetRatio is take-home pay as a share of gross (13% flat income tax)
+	DefaultNetRatio = 0.87
+	hoursPerYear    = 2080
+)
+
+// SalaryConfig controls how salaries are normalized to annual gross pay in one currency
+type SalaryConfig struct {
+	BaseCurrency string
+	// Rates are the value of one unit of each currency in the base currency ("EUR": 1.08)
+	Rates map[string]float64
+	// NetRatio converts net ("на руки") figures to gross: gross = net / NetRatio
+	NetRatio float64
+}
+
+// DefaultSalaryConfig compares salaries in USD and converts only currencies that need no rate
+func DefaultSalaryConfig() SalaryConfig {
+	return SalaryConfig{BaseCurrency: DefaultBaseCurrency, NetRatio: DefaultNetRatio}
+}
+
+// SalaryRange is a salary band or expectation as written, with Max 0 for open-ended ranges
+type SalaryRange struct {
+	Min      float64 `json:"min,omitempty"`
+	Max      float64 `json:"max,omitempty"`
+	Currency string  `json:"currency,omitempty"` // ISO code, "" when not stated
+	Period   string  `json:"period"`
+	Net      bool    `json:"net,omitempty"`
+	Text     string  `json:"text"`
+}
+
+// SalaryMatch reports how the CV expectation compares with the JD band. Normalized figures
+// are annual gross pay in the base currency.
+type SalaryMatch struct {
+	JD       *SalaryRange `json:"jd,omitempty"`
+	CV       *SalaryRange `json:"cv,omitempty"`
+	Currency string       `json:"currency"`
+	JDMin    float64      `json:"jd_min,omitempty"`
+	JDMax    float64      `json:"jd_max,omitempty"` // 0 when the band has no upper bound
+	CVMin    float64      `json:"cv_min,omitempty"`
+	CVMax    float64      `json:"cv_max,omitempty"`
+	Overlap  float64      `json:"overlap"` // 0.0-1.0 share of the expectation inside the band
+	Status   string       `json:"status"`
+	Reason   string       `json:"reason"`
+}
+
+var (
+	// amountPattern matches "120,000", "120 000", "3.5k", "150к", "300 тыс", "1.2m"
+	amountPattern = regexp.MustCompile(`(\d{1,3}(?:[ \x{00a0}\x{202f},.]\d{3})+|\d+(?:[.,]\d+)?)\s?(k|к|тыс\.?|thousand|m|млн)?`)
+	// decimalSeparatorPattern splits an amount at "," and "."
+	decimalSeparatorPattern = regexp.MustCompile(`[,.]`)
+	// rangeSeparatorPattern joins the two ends of a range ("120-150k", "от 200 до 300 тыс")
+	rangeSeparatorPattern = regexp.MustCompile(`^\s*(?:[$€£₽]|usd|eur|gbp|rub)?\s*(?:-|–|—|to|до|and)\s*(?:[$€£₽]|usd|eur|gbp|rub)?\s*$`)
+	// minimumPattern and maximumPattern mark one-sided amounts ("from $100k", "до 300 000")
+	minimumPattern = regexp.MustCompile(`(?:from|от|starting at|at least|min(?:imum)?\.?|не менее)\s*(?:[$€£₽]\s*)?$`)
+	maximumPattern = regexp.MustCompile(`(?:up to|upto|до|max(?:imum)?\.?|не более)\s*(?:[$€£₽]\s*)?$`)
+
+	currencyPatterns = []struct {
+		Pattern  *regexp.Regexp
+		Currency string
+	}{
+		{regexp.MustCompile(`\$|usd|dollars?|долл`), "USD"},
+		{regexp.MustCompile(`€|eur|евро`), "EUR"},
+		{regexp.MustCompile(`£|gbp|pounds?`), "GBP"},
+		{regexp.MustCompile(`₽|rub|руб|р\.`), "RUB"},
+	}
+	periodPatterns = []struct {
+		Pattern *regexp.Regexp
+		Period  string
+	}{
+		{regexp.MustCompile(`per hour|an hour|/\s?h(?:ou)?r|hourly|в час|/\s?час`), PeriodHour},
+		{regexp.MustCompile(`per month|a month|/\s?mo(?:nth)?|monthly|в месяц|/\s?мес|в мес|ежемесячно`), PeriodMonth},
+		{regexp.MustCompile(`per year|a year|/\s?y(?:ea)?r|annual|yearly|per annum|p\.a\.|в год|/\s?год|годов`), PeriodYear},
+	}
+	netPattern   = regexp.MustCompile(`\bnet\b|нетто|на руки|после вычета|после налог`)
+	grossPattern = regexp.MustCompile(`\bgross\b|брутто|до вычета|до налог`)
+
+	// salaryCues mark sentences that state pay; CV sentences also need an expectation cue
+	salaryCues      = regexp.MustCompile(`salary|compensation|\bpay\b|\brate\b|зарплат|зп\b|оклад|доход|вознагражден`)
+	expectationCues = regexp.MustCompile(`expect|desired|target|looking for|ожидани|желаем|рассматриваю|от\s`)
+)
+
+// ParseSalary parses a salary band or expectation such as "$120k-150k per year",
+// "€5,000/month gross" or "от 250 000 ₽ на руки"
+func ParseSalary(text string) (SalaryRange, bool) {
+	lower := strings.ToLower(text)
+	amounts := amountPattern.FindAllStringSubmatchIndex(lower, -1)
+
+	r := SalaryRange{Text: strings.TrimSpace(text), Currency: salaryCurrency(lower)}
+	var values []float64
+	for i, m := range amounts {
+		value, ok := parseAmount(lower[m[2]:m[3]])
+		if !ok {
+			continue
+		}
+		multiplier := amountMultiplier(lower, m)
+		value *= multiplier
+		if len(values) == 0 {
+			prefix := lower[:m[0]]
+			switch {
+			case minimumPattern.MatchString(prefix):
+				r.Min = value
+			case maximumPattern.MatchString(prefix):
+				r.Max = value
+			default:
+				r.Min, r.Max = value, value
+			}
+			values = append(values, value)
+			if i+1 < len(amounts) && rangeSeparatorPattern.MatchString(lower[m[1]:amounts[i+1][0]]) {
+				continue
+			}
+			break
+		}
+		// Second end of a range: "120-150k" applies the multiplier to both ends
+		if multiplier > 1 && values[0] < 1000 {
+			r.Min = values[0] * multiplier
+		} else if r.Min == 0 {
+			r.Min = values[0]
+		}
+		r.Max = value
+		break
+	}
+	// Without a currency only sizeable amounts next to a salary cue count ("Salary: 120-150k")
+	amount := math.Max(r.Min, r.Max)
+	if len(values) == 0 || r.Currency == "" && (amount < 1000 || !salaryCues.MatchString(lower)) {
+		return SalaryRange{}, false
+	}
+
+	r.Period = salaryPeriod(lower, r.Currency, amount)
+	r.Net = netPattern.MatchString(lower) && !grossPattern.MatchString(lower)
+	return r, true
+}
+
+// parseAmount parses "120,000", "120 000", "3.5" or "3,5"
+func parseAmount(s string) (float64, bool) {
+	s = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "").Replace(s)
+	// A separator followed by exactly three digits groups thousands
+	if parts := decimalSeparatorPattern.Split(s, -1); len(parts) > 1 && len(parts[len(parts)-1]) == 3 {
+		s = strings.Join(parts, "")
+	} else {
+		s = strings.ReplaceAll(s, ",", ".")
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	return value, err == nil && value > 0
+}
+
+// amountMultiplier returns the multiplier of an amount match's "k", "тыс" or "m" suffix,
+// or 1 when there is none or the suffix starts a word ("5000 monthly")
+func amountMultiplier(text string, m []int) float64 {
+	if m[4] < 0 {
+		return 1
+	}
+	if r, _ := utf8.DecodeRuneInString(text[m[5]:]); unicode.IsLetter(r) {
+		return 1
+	}
+	switch text[m[4]:m[5]] {
+	case "m", "млн":
+		return 1_000_000
+	default:
+		return 1000
+	}
+}
+
+// salaryCurrency returns the first currency named in text, or ""
+func salaryCurrency(text string) string {
+	first, currency := len(text)+1, ""
+	for _, c := range currencyPatterns {
+		if loc := c.Pattern.FindStringIndex(text); loc != nil && loc[0] < first {
+			first, currency = loc[0], c.Currency
+		}
+	}
+	return currency
+}
+
+// salaryPeriod returns the stated pay period, or infers it from the amount: Russian salaries
+// are quoted per month, small amounts are hourly and mid-size ones monthly
+func salaryPeriod(text, currency string, amount float64) string {
+	for _, p := range periodPatterns {
+		if p.Pattern.MatchString(text) {
+			return p.Period
+		}
+	}
+	switch {
+	case amount < 500:
+		return PeriodHour
+	case currency == "RUB" && amount < 2_000_000, amount < 20_000:
+		return PeriodMonth
+	default:
+		return PeriodYear
+	}
+}
+
+// ExtractJDSalary returns the salary band a job description states
+func ExtractJDSalary(content string, jd *parse.JobDescription) (SalaryRange, bool) {
+	if jd.SalaryRange != "" {
+		if r, ok := ParseSalary(jd.SalaryRange); ok {
+			return r, true
+		}
+	}
+	for _, sentence := range strings.Split(content, "\n") {
+		if salaryCues.MatchString(strings.ToLower(sentence)) {
+			if r, ok := ParseSalary(sentence); ok {
+				return r, true
+			}
+		}
+	}
+	return SalaryRange{}, false
+}
+
+// ExtractCVSalary returns the salary expectation a CV states ("Salary expectations: €70k")
+func ExtractCVSalary(content string) (SalaryRange, bool) {
+	for _, line := range strings.Split(content, "\n") {
+		lower := strings.ToLower(line)
+		if salaryCues.MatchString(lower) && expectationCues.MatchString(lower) {
+			if r, ok := ParseSalary(line); ok {
+				return r, true
+			}
+		}
+	}
+	return SalaryRange{}, false
+}
+
+// Annual converts a salary range to annual gross pay in the base currency.
+// It fails when no exchange rate is configured for the range's currency.
+func (r SalaryRange) Annual(cfg SalaryConfig, currency string) (minimum, maximum float64, err error) {
+	rate := 1.0
+	if currency != cfg.BaseCurrency {
+		var ok bool
+		if rate, ok = cfg.Rates[currency]; !ok {
+			return 0, 0, fmt.Errorf("no exchange rate for %s", currency)
+		}
+	}
+
+	factor := rate
+	switch r.Period {
+	case PeriodHour:
+		factor *= hoursPerYear
+	case PeriodMonth:
+		factor *= 12
+	}
+	if r.Net && cfg.NetRatio > 0 {
+		factor /= cfg.NetRatio
+	}
+	return r.Min * factor, r.Max * factor, nil
+}
+
+// MatchSalary compares a CV expectation with a JD band. Ranges without a currency are assumed
+// to use the other side's currency.
+func MatchSalary(cv, jd *SalaryRange, cfg SalaryConfig) *SalaryMatch {
+	if cv == nil && jd == nil {
+		return nil
+	}
+	match := &SalaryMatch{JD: jd, CV: cv, Currency: cfg.BaseCurrency, Status: SalaryUnknown}
+	switch {
+	case jd == nil:
+		match.Reason = "JD states no salary band"
+		return match
+	case cv == nil:
+		match.Reason = "CV states no salary expectation"
+		return match
+	}
+
+	jdCurrency, cvCurrency := currencyOr(jd.Currency, cv.Currency, cfg), currencyOr(cv.Currency, jd.Currency, cfg)
+	// Both sides in the same currency compare directly when there is no rate for it
+	if _, ok := cfg.Rates[jdCurrency]; jdCurrency == cvCurrency && !ok {
+		cfg.BaseCurrency, match.Currency = jdCurrency, jdCurrency
+	}
+	jdMin, jdMax, err := jd.Annual(cfg, jdCurrency)
+	if err != nil {
+		match.Reason = err.Error()
+		return match
+	}
+	cvMin, cvMax, err := cv.Annual(cfg, cvCurrency)
+	if err != nil {
+		match.Reason = err.Error()
+		return match
+	}
+	match.JDMin, match.JDMax, match.CVMin, match.CVMax = jdMin, jdMax, cvMin, cvMax
+
+	// Open ends: a band "from X" has no ceiling, an expectation "from X" is the floor
+	bandMax := jdMax
+	if bandMax == 0 {
+		bandMax = math.Inf(1)
+	}
+	if cvMax == 0 {
+		cvMax = cvMin
+	}
+	if cvMin == 0 {
+		cvMin = cvMax
+	}
+
+	match.Overlap = overlapShare(cvMin, cvMax, jdMin, bandMax)
+	switch {
+	case cvMin > bandMax:
+		match.Status = SalaryAbove
+		match.Reason = fmt.Sprintf("expectation is %.0f%% above the band", (cvMin/bandMax-1)*100)
+	case cvMax < jdMin:
+		match.Status = SalaryBelow
+		match.Reason = "expectation is below the band"
+	case cvMin >= jdMin && cvMax <= bandMax:
+		match.Status, match.Reason = SalaryWithin, "expectation fits the band"
+	default:
+		match.Status = SalaryOverlap
+		match.Reason = fmt.Sprintf("%.0f%% of the expectation fits the band", match.Overlap*100)
+	}
+	return match
+}
+
+// currencyOr returns currency, or fallback when it is unknown, or the base currency
+func currencyOr(currency, fallback string, cfg SalaryConfig) string {
+	switch {
+	case currency != "":
+		return currency
+	case fallback != "":
+		return fallback
+	default:
+		return cfg.BaseCurrency
+	}
+}
+
+// overlapShare returns the share of [lo, hi] inside [bandLo, bandHi]; a point counts fully or not at all
+func overlapShare(lo, hi, bandLo, bandHi float64) float64 {
+	if hi <= lo {
+		if lo >= bandLo && lo <= bandHi {
+			return 1.0
+		}
+		return 0.0
+	}
+	inside := math.Min(hi, bandHi) - math.Max(lo, bandLo)
+	return math.Max(0, inside) / (hi - lo)
+}
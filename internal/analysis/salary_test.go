@@ -0,0 +1,102 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+func TestParseSalary(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected SalaryRange
+	}{
+		{"$120k-150k per year", SalaryRange{Min: 120000, Max: 150000, Currency: "USD", Period: PeriodYear}},
+		{"Salary: 120-150k", SalaryRange{Min: 120000, Max: 150000, Period: PeriodYear}},
+		{"€5,000/month gross", SalaryRange{Min: 5000, Max: 5000, Currency: "EUR", Period: PeriodMonth}},
+		{"от 250 000 ₽ на руки", SalaryRange{Min: 250000, Currency: "RUB", Period: PeriodMonth, Net: true}},
+		{"от 200 до 300 тыс. руб.", SalaryRange{Min: 200000, Max: 300000, Currency: "RUB", Period: PeriodMonth}},
+		{"Up to £90,000", SalaryRange{Max: 90000, Currency: "GBP", Period: PeriodYear}},
+		{"$60/hour", SalaryRange{Min: 60, Max: 60, Currency: "USD", Period: PeriodHour}},
+		{"1,5 млн рублей в год", SalaryRange{Min: 1500000, Max: 1500000, Currency: "RUB", Period: PeriodYear}},
+		{"EUR 6000 monthly net", SalaryRange{Min: 6000, Max: 6000, Currency: "EUR", Period: PeriodMonth, Net: true}},
+	}
+
+	for _, tt := range tests {
+		r, ok := ParseSalary(tt.text)
+		if !ok {
+			t.Errorf("ParseSalary(%q) found no salary", tt.text)
+			continue
+		}
+		r.Text = ""
+		if r != tt.expected {
+			t.Errorf("ParseSalary(%q) = %+v, want %+v", tt.text, r, tt.expected)
+		}
+	}
+}
+
+func TestParseSalary_NotSalary(t *testing.T) {
+	for _, text := range []string{
+		"5+ years of corporate experience",
+		"Reduced latency by 40%",
+		"Accurate rate limiting for 3 services",
+	} {
+		if r, ok := ParseSalary(text); ok {
+			t.Errorf("ParseSalary(%q) = %+v, want no salary", text, r)
+		}
+	}
+}
+
+func TestExtractSalary(t *testing.T) {
+	jdContent := "# Backend Engineer\n\nSalary: €70,000 - €90,000 per year\n\n## Requirements\n- 5 years of Go\n"
+	jd, ok := ExtractJDSalary(jdContent, parse.ParseJD(jdContent))
+	if !ok || jd.Min != 70000 || jd.Max != 90000 || jd.Currency != "EUR" {
+		t.Errorf("Unexpected JD salary: %+v", jd)
+	}
+
+	cvContent := "# Jane Doe\n\nReduced costs by $2M.\nSalary expectations: €80k gross per year\n"
+	cv, ok := ExtractCVSalary(cvContent)
+	if !ok || cv.Min != 80000 || cv.Currency != "EUR" {
+		t.Errorf("Unexpected CV salary: %+v", cv)
+	}
+
+	if _, ok := ExtractCVSalary("# Jane Doe\n\nGrew revenue to $2M per year\n"); ok {
+		t.Error("Expected no expectation in a CV without a salary statement")
+	}
+}
+
+func TestMatchSalary(t *testing.T) {
+	cfg := SalaryConfig{BaseCurrency: "EUR", Rates: map[string]float64{"USD": 0.9, "RUB": 0.01}, NetRatio: 0.87}
+	band := &SalaryRange{Min: 70000, Max: 90000, Currency: "EUR", Period: PeriodYear}
+
+	tests := []struct {
+		name    string
+		cv      *SalaryRange
+		jd      *SalaryRange
+		status  string
+		overlap float64
+	}{
+		{"within", &SalaryRange{Min: 80000, Max: 80000, Currency: "EUR", Period: PeriodYear}, band, SalaryWithin, 1.0},
+		{"partial overlap", &SalaryRange{Min: 80000, Max: 100000, Currency: "EUR", Period: PeriodYear}, band, SalaryOverlap, 0.5},
+		{"above", &SalaryRange{Min: 10000, Max: 10000, Currency: "EUR", Period: PeriodMonth}, band, SalaryAbove, 0.0},
+		{"converted currency", &SalaryRange{Min: 90000, Max: 90000, Currency: "USD", Period: PeriodYear}, band, SalaryWithin, 1.0},
+		{"net to gross", &SalaryRange{Min: 580000, Max: 580000, Currency: "RUB", Period: PeriodMonth, Net: true}, band, SalaryWithin, 1.0},
+		{"open band", &SalaryRange{Min: 150000, Max: 150000, Currency: "EUR", Period: PeriodYear}, &SalaryRange{Min: 70000, Currency: "EUR", Period: PeriodYear}, SalaryWithin, 1.0},
+		{"same unrated currency", &SalaryRange{Min: 5000, Max: 5000, Currency: "GBP", Period: PeriodMonth}, &SalaryRange{Min: 50000, Max: 70000, Currency: "GBP", Period: PeriodYear}, SalaryWithin, 1.0},
+		{"missing rate", &SalaryRange{Min: 80000, Max: 80000, Currency: "GBP", Period: PeriodYear}, band, SalaryUnknown, 0.0},
+		{"no expectation", nil, band, SalaryUnknown, 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := MatchSalary(tt.cv, tt.jd, cfg)
+			if match.Status != tt.status || !almostEqual(match.Overlap, tt.overlap, 0.001) {
+				t.Errorf("Expected %s with overlap %.2f, got %+v", tt.status, tt.overlap, match)
+			}
+		})
+	}
+
+	if MatchSalary(nil, nil, cfg) != nil {
+		t.Error("Expected no match without salaries")
+	}
+}
@@ -9,10 +9,11 @@ import (
 // ScoringWeights defines weights for different scoring dimensions
 // Weights should sum to 1.0 for normalized scoring
 type ScoringWeights struct {
-	SkillCoverage  float64 `json:"skill_coverage"`  // Default: 0.40 (40%)
-	Experience     float64 `json:"experience"`      // Default: 0.30 (30%)
+	SkillCoverage  float64 `json:"skill_coverage"`  // Default: 0.35 (35%)
+	Experience     float64 `json:"experience"`      // Default: 0.25 (25%)
 	TermSimilarity float64 `json:"term_similarity"` // Default: 0.20 (20%)
 	OverallMatch   float64 `json:"overall_match"`   // Default: 0.10 (10%)
+	Proficiency    float64 `json:"proficiency"`     // Default: 0.10 (10%)
 }
 
 // ScoreBreakdown provides detailed scoring information
@@ -21,23 +22,25 @@ type ScoreBreakdown struct {
 	Experience     float64 `json:"experience_match"`
 	TermSimilarity float64 `json:"term_similarity"`
 	OverallMatch   float64 `json:"overall_match"`
+	Proficiency    float64 `json:"proficiency_alignment"`
 	WeightedTotal  int     `json:"weighted_total"`
 }
 
 // NewDefaultWeights creates scoring weights with standard defaults
-// Skill coverage: 40%, Experience: 30%, Term similarity: 20%, Overall match: 10%
+// Skill coverage: 35%, Experience: 25%, Term similarity: 20%, Overall match: 10%, Proficiency: 10%
 func NewDefaultWeights() ScoringWeights {
 	return ScoringWeights{
-		SkillCoverage:  0.40,
-		Experience:     0.30,
+		SkillCoverage:  0.35,
+		Experience:     0.25,
 		TermSimilarity: 0.20,
 		OverallMatch:   0.10,
+		Proficiency:    0.10,
 	}
 }
 
 // ValidateWeights checks if weights sum to 1.0 (within tolerance)
 func (w ScoringWeights) ValidateWeights() error {
-	sum := w.SkillCoverage + w.Experience + w.TermSimilarity + w.OverallMatch
+	sum := w.SkillCoverage + w.Experience + w.TermSimilarity + w.OverallMatch + w.Proficiency
 	if sum < 0.99 || sum > 1.01 {
 		return fmt.Errorf("weights must sum to 1.0, got %.3f", sum)
 	}
@@ -46,7 +49,7 @@ func (w ScoringWeights) ValidateWeights() error {
 
 // Normalize ensures weights sum to 1.0
 func (w ScoringWeights) Normalize() ScoringWeights {
-	sum := w.SkillCoverage + w.Experience + w.TermSimilarity + w.OverallMatch
+	sum := w.SkillCoverage + w.Experience + w.TermSimilarity + w.OverallMatch + w.Proficiency
 	if sum == 0 {
 		return NewDefaultWeights()
 	}
@@ -55,6 +58,7 @@ func (w ScoringWeights) Normalize() ScoringWeights {
 		Experience:     w.Experience / sum,
 		TermSimilarity: w.TermSimilarity / sum,
 		OverallMatch:   w.OverallMatch / sum,
+		Proficiency:    w.Proficiency / sum,
 	}
 }
 
@@ -66,6 +70,7 @@ func CalculateWeightedScore(
 	experienceMatch float64,
 	termSimilarity float64,
 	overallMatch float64,
+	proficiencyAlignment float64,
 	weights ScoringWeights,
 ) (int, *ScoreBreakdown) {
 	// Ensure weights are normalized
@@ -76,12 +81,14 @@ func CalculateWeightedScore(
 	experienceMatch = clampFloat64(experienceMatch, 0.0, 1.0)
 	termSimilarity = clampFloat64(termSimilarity, 0.0, 1.0)
 	overallMatch = clampFloat64(overallMatch, 0.0, 1.0)
+	proficiencyAlignment = clampFloat64(proficiencyAlignment, 0.0, 1.0)
 
 	// Calculate weighted total
 	total := (skillCoverage * normalized.SkillCoverage) +
 		(experienceMatch * normalized.Experience) +
 		(termSimilarity * normalized.TermSimilarity) +
-		(overallMatch * normalized.OverallMatch)
+		(overallMatch * normalized.OverallMatch) +
+		(proficiencyAlignment * normalized.Proficiency)
 
 	// Convert to 0-100 scale and round
 	score := int(total * 100)
@@ -99,6 +106,7 @@ func CalculateWeightedScore(
 		Experience:     experienceMatch,
 		TermSimilarity: termSimilarity,
 		OverallMatch:   overallMatch,
+		Proficiency:    proficiencyAlignment,
 		WeightedTotal:  score,
 	}
 
@@ -106,7 +114,8 @@ func CalculateWeightedScore(
 }
 
 // CalculateExperienceMatch computes experience match score
-// Higher score = better match between CV and JD experience requirements
+// Higher score = better match between CV and JD experience requirements, including "3-5 years" style ranges.
+// Skills the CV hasn't used recently are discounted by their recency factor.
 func CalculateExperienceMatch(cvSkills, jdSkills []Skill) float64 {
 	if len(jdSkills) == 0 {
 		return 0.0
@@ -123,24 +132,7 @@ func CalculateExperienceMatch(cvSkills, jdSkills []Skill) float64 {
 	for _, jdSkill := range jdSkills {
 		for _, cvSkill := range matches {
 			if cvSkill.Name == jdSkill.Name {
-				// Calculate experience match
-				// If JD specifies experience, compare with CV
-				// If JD doesn't specify, any CV experience counts as match
-				if jdSkill.Experience > 0 {
-					if cvSkill.Experience >= jdSkill.Experience {
-						totalScore += 1.0
-					} else if cvSkill.Experience > 0 {
-						// Partial match: ratio of CV experience to JD requirement
-						totalScore += float64(cvSkill.Experience) / float64(jdSkill.Experience)
-					}
-				} else {
-					// JD doesn't specify experience requirement
-					if cvSkill.Experience > 0 {
-						totalScore += 0.8 // Good match
-					} else {
-						totalScore += 0.5 // Some experience (assumed)
-					}
-				}
+				totalScore += experienceScore(cvSkill, jdSkill) * cvSkill.recencyWeight()
 				totalPossible += 1.0
 				break
 			}
@@ -154,6 +146,31 @@ func CalculateExperienceMatch(cvSkills, jdSkills []Skill) float64 {
 	return totalScore / totalPossible
 }
 
+// overqualifiedScore is the experience score when the CV exceeds the upper bound of a JD range
+const overqualifiedScore = 0.8
+
+// experienceScore scores a matched skill's CV experience against the JD requirement range
+func experienceScore(cvSkill, jdSkill Skill) float64 {
+	switch {
+	case jdSkill.Experience == 0 && jdSkill.ExperienceMax == 0:
+		// JD doesn't specify experience requirement
+		if cvSkill.Experience > 0 {
+			return 0.8 // Good match
+		}
+		return 0.5 // Some experience (assumed)
+	case cvSkill.Experience == 0 && jdSkill.Experience == 0:
+		// Only an upper bound ("up to 2 years") and the CV states nothing
+		return 0.5
+	case cvSkill.Experience < jdSkill.Experience:
+		// Partial match: ratio of CV experience to JD minimum
+		return float64(cvSkill.Experience) / float64(jdSkill.Experience)
+	case jdSkill.ExperienceMax > 0 && cvSkill.Experience > jdSkill.ExperienceMax:
+		return overqualifiedScore
+	default:
+		return 1.0
+	}
+}
+
 // CalculateTermSimilarity computes term-based similarity score
 // Uses the provided similarity score directly (from LLM or BM25 analysis)
 func CalculateTermSimilarity(termSimilarityScore float64) float64 {
@@ -171,14 +188,32 @@ func CalculateOverallMatch(overallMatchScore float64) float64 {
 }
 
 // CalculateSkillCoverage computes skill coverage percentage
-// Returns value in 0.0-1.0 range
+// Returns value in 0.0-1.0 range; preferred JD skills carry half the weight of required ones
+// and stale CV skills or CV versions older than required are discounted
 func CalculateSkillCoverage(cvSkills, jdSkills []Skill) float64 {
 	if len(jdSkills) == 0 {
 		return 0.0
 	}
 
 	matches, _, _ := MatchSkills(cvSkills, jdSkills)
-	return float64(len(matches)) / float64(len(jdSkills))
+
+	// Preferred skills count for less than required ones
+	total, matched := 0.0, 0.0
+	for _, skill := range jdSkills {
+		total += skillWeight(skill)
+	}
+	for _, skill := range matches {
+		matched += skillWeight(skill) * skill.recencyWeight() * versionWeight(skill.VersionMatch)
+	}
+	return matched / total
+}
+
+// skillWeight returns the coverage weight of a JD skill
+func skillWeight(skill Skill) float64 {
+	if skill.Requirement == RequirementPreferred {
+		return preferredSkillWeight
+	}
+	return 1.0
 }
 
 // clampFloat64 clamps a float64 value to the given range
@@ -226,12 +261,13 @@ func (w ScoringWeights) ToJSON() (string, error) {
 }
 
 // NewScoringWeights creates custom scoring weights
-func NewScoringWeights(skillCoverage, experience, termSimilarity, overallMatch float64) (ScoringWeights, error) {
+func NewScoringWeights(skillCoverage, experience, termSimilarity, overallMatch, proficiency float64) (ScoringWeights, error) {
 	weights := ScoringWeights{
 		SkillCoverage:  skillCoverage,
 		Experience:     experience,
 		TermSimilarity: termSimilarity,
 		OverallMatch:   overallMatch,
+		Proficiency:    proficiency,
 	}
 
 	if err := weights.ValidateWeights(); err != nil {
@@ -248,6 +284,7 @@ func (s *ScoreBreakdown) LogDebug(ctx context.Context, msg string) {
 		"experience_match", s.Experience,
 		"term_similarity", s.TermSimilarity,
 		"overall_match", s.OverallMatch,
+		"proficiency_alignment", s.Proficiency,
 		"weighted_total", s.WeightedTotal,
 	)
 }
@@ -14,11 +14,11 @@ func almostEqual(a, b, tolerance float64) bool {
 func TestNewDefaultWeights(t *testing.T) {
 	weights := NewDefaultWeights()
 
-	if weights.SkillCoverage != 0.40 {
-		t.Errorf("Expected SkillCoverage 0.40, got %f", weights.SkillCoverage)
+	if weights.SkillCoverage != 0.35 {
+		t.Errorf("Expected SkillCoverage 0.35, got %f", weights.SkillCoverage)
 	}
-	if weights.Experience != 0.30 {
-		t.Errorf("Expected Experience 0.30, got %f", weights.Experience)
+	if weights.Experience != 0.25 {
+		t.Errorf("Expected Experience 0.25, got %f", weights.Experience)
 	}
 	if weights.TermSimilarity != 0.20 {
 		t.Errorf("Expected TermSimilarity 0.20, got %f", weights.TermSimilarity)
@@ -26,6 +26,9 @@ func TestNewDefaultWeights(t *testing.T) {
 	if weights.OverallMatch != 0.10 {
 		t.Errorf("Expected OverallMatch 0.10, got %f", weights.OverallMatch)
 	}
+	if weights.Proficiency != 0.10 {
+		t.Errorf("Expected Proficiency 0.10, got %f", weights.Proficiency)
+	}
 }
 
 func TestScoringWeights_ValidateWeights(t *testing.T) {
@@ -33,11 +36,11 @@ func TestScoringWeights_ValidateWeights(t *testing.T) {
 		weights   ScoringWeights
 		shouldErr bool
 	}{
-		{NewDefaultWeights(), false},                  // 0.40 + 0.30 + 0.20 + 0.10 = 1.0
-		{ScoringWeights{0.5, 0.3, 0.1, 0.1}, false},   // Sum: 1.0
-		{ScoringWeights{0.8, 0.1, 0.05, 0.05}, false}, // Sum: 1.0
-		{ScoringWeights{0.6, 0.3, 0.3, 0.0}, true},    // Sum: 1.2 (too high)
-		{ScoringWeights{0.2, 0.1, 0.05, 0.05}, true},  // Sum: 0.4 (too low)
+		{NewDefaultWeights(), false},                       // 0.35 + 0.25 + 0.20 + 0.10 + 0.10 = 1.0
+		{ScoringWeights{0.5, 0.3, 0.1, 0.1, 0.0}, false},   // Sum: 1.0
+		{ScoringWeights{0.8, 0.1, 0.05, 0.0, 0.05}, false}, // Sum: 1.0
+		{ScoringWeights{0.6, 0.3, 0.3, 0.0, 0.0}, true},    // Sum: 1.2 (too high)
+		{ScoringWeights{0.2, 0.1, 0.05, 0.05, 0.0}, true},  // Sum: 0.4 (too low)
 	}
 
 	for _, tt := range tests {
@@ -57,16 +60,20 @@ func TestScoringWeights_Normalize(t *testing.T) {
 		expected ScoringWeights
 	}{
 		{
-			ScoringWeights{0.4, 0.3, 0.2, 0.1},
-			ScoringWeights{0.4, 0.3, 0.2, 0.1},
+			ScoringWeights{0.35, 0.25, 0.2, 0.1, 0.1},
+			ScoringWeights{0.35, 0.25, 0.2, 0.1, 0.1},
+		},
+		{
+			ScoringWeights{0.5, 0.5, 0.0, 0.0, 0.0},
+			ScoringWeights{0.5, 0.5, 0.0, 0.0, 0.0},
 		},
 		{
-			ScoringWeights{0.5, 0.5, 0.0, 0.0},
-			ScoringWeights{0.5, 0.5, 0.0, 0.0},
+			ScoringWeights{0.8, 0.4, 0.2, 0.2, 0.0},      // Sum: 1.6
+			ScoringWeights{0.5, 0.25, 0.125, 0.125, 0.0}, // Normalized to 1.0
 		},
 		{
-			ScoringWeights{0.8, 0.4, 0.2, 0.2},      // Sum: 1.6
-			ScoringWeights{0.5, 0.25, 0.125, 0.125}, // Normalized to 1.0
+			ScoringWeights{0.4, 0.4, 0.0, 0.0, 0.8}, // Sum: 1.6
+			ScoringWeights{0.25, 0.25, 0.0, 0.0, 0.5},
 		},
 	}
 
@@ -76,7 +83,8 @@ func TestScoringWeights_Normalize(t *testing.T) {
 		if !almostEqual(result.SkillCoverage, tt.expected.SkillCoverage, 0.001) ||
 			!almostEqual(result.Experience, tt.expected.Experience, 0.001) ||
 			!almostEqual(result.TermSimilarity, tt.expected.TermSimilarity, 0.001) ||
-			!almostEqual(result.OverallMatch, tt.expected.OverallMatch, 0.001) {
+			!almostEqual(result.OverallMatch, tt.expected.OverallMatch, 0.001) ||
+			!almostEqual(result.Proficiency, tt.expected.Proficiency, 0.001) {
 			t.Errorf("Test %d: expected %+v, got %+v", i, tt.expected, result)
 		}
 	}
@@ -84,16 +92,19 @@ func TestScoringWeights_Normalize(t *testing.T) {
 
 func TestNewScoringWeights(t *testing.T) {
 	// Valid weights
-	weights, err := NewScoringWeights(0.4, 0.3, 0.2, 0.1)
+	weights, err := NewScoringWeights(0.35, 0.25, 0.2, 0.1, 0.1)
 	if err != nil {
 		t.Errorf("Expected no error for valid weights: %v", err)
 	}
-	if weights.SkillCoverage != 0.4 {
-		t.Errorf("Expected SkillCoverage 0.4, got %f", weights.SkillCoverage)
+	if weights.SkillCoverage != 0.35 {
+		t.Errorf("Expected SkillCoverage 0.35, got %f", weights.SkillCoverage)
+	}
+	if weights.Proficiency != 0.1 {
+		t.Errorf("Expected Proficiency 0.1, got %f", weights.Proficiency)
 	}
 
 	// Invalid weights (don't sum to 1.0)
-	_, err = NewScoringWeights(0.6, 0.3, 0.3, 0.0)
+	_, err = NewScoringWeights(0.6, 0.3, 0.3, 0.0, 0.0)
 	if err == nil {
 		t.Error("Expected error for invalid weights that don't sum to 1.0")
 	}
@@ -103,22 +114,24 @@ func TestCalculateWeightedScore(t *testing.T) {
 	weights := NewDefaultWeights()
 
 	tests := []struct {
-		name            string
-		skillCoverage   float64
-		experienceMatch float64
-		termSimilarity  float64
-		overallMatch    float64
-		expectedMin     int
-		expectedMax     int
+		name                 string
+		skillCoverage        float64
+		experienceMatch      float64
+		termSimilarity       float64
+		overallMatch         float64
+		proficiencyAlignment float64
+		expectedMin          int
+		expectedMax          int
 	}{
 		{
-			name:            "Perfect score",
-			skillCoverage:   1.0,
-			experienceMatch: 1.0,
-			termSimilarity:  1.0,
-			overallMatch:    1.0,
-			expectedMin:     95,
-			expectedMax:     100,
+			name:                 "Perfect score",
+			skillCoverage:        1.0,
+			experienceMatch:      1.0,
+			termSimilarity:       1.0,
+			overallMatch:         1.0,
+			proficiencyAlignment: 1.0,
+			expectedMin:          95,
+			expectedMax:          100,
 		},
 		{
 			name:            "Zero score",
@@ -130,13 +143,14 @@ func TestCalculateWeightedScore(t *testing.T) {
 			expectedMax:     0,
 		},
 		{
-			name:            "Half score",
-			skillCoverage:   0.5,
-			experienceMatch: 0.5,
-			termSimilarity:  0.5,
-			overallMatch:    0.5,
-			expectedMin:     48,
-			expectedMax:     52,
+			name:                 "Half score",
+			skillCoverage:        0.5,
+			experienceMatch:      0.5,
+			termSimilarity:       0.5,
+			overallMatch:         0.5,
+			proficiencyAlignment: 0.5,
+			expectedMin:          48,
+			expectedMax:          52,
 		},
 		{
 			name:            "High skill coverage only",
@@ -144,8 +158,8 @@ func TestCalculateWeightedScore(t *testing.T) {
 			experienceMatch: 0.0,
 			termSimilarity:  0.0,
 			overallMatch:    0.0,
-			expectedMin:     38,
-			expectedMax:     42, // 40% * 1.0 = 40
+			expectedMin:     33,
+			expectedMax:     37, // 35% * 1.0 = 35
 		},
 		{
 			name:            "High experience only",
@@ -153,8 +167,14 @@ func TestCalculateWeightedScore(t *testing.T) {
 			experienceMatch: 1.0,
 			termSimilarity:  0.0,
 			overallMatch:    0.0,
-			expectedMin:     28,
-			expectedMax:     32, // 30% * 1.0 = 30
+			expectedMin:     23,
+			expectedMax:     27, // 25% * 1.0 = 25
+		},
+		{
+			name:                 "Proficiency alignment only",
+			proficiencyAlignment: 1.0,
+			expectedMin:          8,
+			expectedMax:          12, // 10% * 1.0 = 10
 		},
 	}
 
@@ -165,6 +185,7 @@ func TestCalculateWeightedScore(t *testing.T) {
 				tt.experienceMatch,
 				tt.termSimilarity,
 				tt.overallMatch,
+				tt.proficiencyAlignment,
 				weights,
 			)
 
@@ -190,10 +211,11 @@ func TestCalculateWeightedScore_Clamping(t *testing.T) {
 		-0.5, // Should clamp to 0.0
 		0.5,
 		0.5,
+		0.5,
 		weights,
 	)
 
-	// Expected: (1.0 * 0.4) + (0.0 * 0.3) + (0.5 * 0.2) + (0.5 * 0.1) = 0.4 + 0 + 0.1 + 0.05 = 0.55 = 55
+	// Expected: (1.0 * 0.35) + (0.0 * 0.25) + (0.5 * 0.2) + (0.5 * 0.1) + (0.5 * 0.1) = 0.35 + 0 + 0.1 + 0.05 + 0.05 = 0.55 = 55
 	if score < 54 || score > 56 {
 		t.Errorf("Expected score ~55, got %d", score)
 	}
@@ -213,6 +235,7 @@ func TestCalculateWeightedScore_EdgeCases(t *testing.T) {
 		0.5,
 		0.0,
 		0.0,
+		0.0,
 		customWeights,
 	)
 
@@ -283,6 +306,46 @@ func TestCalculateExperienceMatch(t *testing.T) {
 			},
 			expected: 0.5, // Some experience assumed
 		},
+		{
+			name: "CV within JD range",
+			cvSkills: []Skill{
+				{Name: "go", Experience: 4},
+			},
+			jdSkills: []Skill{
+				{Name: "go", Experience: 3, ExperienceMax: 5},
+			},
+			expected: 1.0,
+		},
+		{
+			name: "CV above JD range",
+			cvSkills: []Skill{
+				{Name: "go", Experience: 10},
+			},
+			jdSkills: []Skill{
+				{Name: "go", Experience: 3, ExperienceMax: 5},
+			},
+			expected: 0.8,
+		},
+		{
+			name: "CV below JD range",
+			cvSkills: []Skill{
+				{Name: "go", Experience: 1},
+			},
+			jdSkills: []Skill{
+				{Name: "go", Experience: 2, ExperienceMax: 4},
+			},
+			expected: 0.5, // 1/2 = 0.5
+		},
+		{
+			name: "JD upper bound only",
+			cvSkills: []Skill{
+				{Name: "go", Experience: 1},
+			},
+			jdSkills: []Skill{
+				{Name: "go", ExperienceMax: 2},
+			},
+			expected: 1.0,
+		},
 		{
 			name: "Empty JD",
 			cvSkills: []Skill{
@@ -344,6 +407,12 @@ func TestCalculateSkillCoverage(t *testing.T) {
 			jdSkills: []Skill{},
 			expected: 0.0,
 		},
+		{
+			// Preferred skills carry half the weight of required ones
+			cvSkills: []Skill{{Name: "go"}},
+			jdSkills: []Skill{{Name: "go", Requirement: RequirementRequired}, {Name: "kafka", Requirement: RequirementPreferred}},
+			expected: 2.0 / 3.0,
+		},
 	}
 
 	for i, tt := range tests {
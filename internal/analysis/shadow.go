@@ -0,0 +1,199 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ShadowPair is a stored CV/JD pair to re-score
+type ShadowPair struct {
+	CvID string
+	JdID string
+	CV   string
+	JD   string
+}
+
+// ScoreDistribution summarizes weighted scores, 0-100
+type ScoreDistribution struct {
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std_dev"`
+	Min    int     `json:"min"`
+	P25    int     `json:"p25"`
+	Median int     `json:"median"`
+	P75    int     `json:"p75"`
+	Max    int     `json:"max"`
+}
+
+// ShadowMove is a pair's score and rank under both engines. Ranks are among the CVs scored against
+// the same JD, 1 being the best.
+type ShadowMove struct {
+	CvID           string `json:"cv_id"`
+	JdID           string `json:"jd_id"`
+	BaselineScore  int    `json:"baseline_score"`
+	CandidateScore int    `json:"candidate_score"`
+	ScoreDelta     int    `json:"score_delta"`
+	BaselineRank   int    `json:"baseline_rank"`
+	CandidateRank  int    `json:"candidate_rank"`
+	RankDelta      int    `json:"rank_delta"` // Positive means the CV moved up
+}
+
+// ShadowReport compares how a baseline and a candidate engine score the same pairs
+type ShadowReport struct {
+	Pairs     int               `json:"pairs"`
+	JDs       int               `json:"jds"`
+	Baseline  ScoreDistribution `json:"baseline"`
+	Candidate ScoreDistribution `json:"candidate"`
+	MeanShift float64           `json:"mean_shift"` // Candidate mean minus baseline mean
+	// Spearman is the rank correlation of both engines' scores over all pairs; 1 means the order is unchanged
+	Spearman     float64 `json:"spearman"`
+	RankChanges  int     `json:"rank_changes"`  // Pairs whose rank for their JD changed
+	TopKChanges  int     `json:"top_k_changes"` // Pairs entering or leaving a JD's top k
+	K            int     `json:"k"`             // Cutoff for TopKChanges
+	MaxMovement  int     `json:"max_movement"`  // Largest absolute score change
+	ChangedPairs int     `json:"changed_pairs"` // Pairs whose score changed
+	// Movers are the pairs with the largest score change, then rank change
+	Movers []ShadowMove `json:"movers"`
+}
+
+// CompareEngines scores every pair with both engines and reports rank changes within each JD,
+// the shift in the score distribution and the pairs that move the most (at most top)
+func CompareEngines(ctx context.Context, baseline, candidate *AnalysisEngine, pairs []ShadowPair, k, top int) (*ShadowReport, error) {
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("no pairs to compare")
+	}
+
+	moves := make([]ShadowMove, len(pairs))
+	baseScores := make([]float64, len(pairs))
+	candScores := make([]float64, len(pairs))
+	for i, pair := range pairs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		base, err := baseline.Analyze(ctx, pair.CV, pair.JD)
+		if err != nil {
+			return nil, fmt.Errorf("baseline %s/%s: %w", pair.CvID, pair.JdID, err)
+		}
+		cand, err := candidate.Analyze(ctx, pair.CV, pair.JD)
+		if err != nil {
+			return nil, fmt.Errorf("candidate %s/%s: %w", pair.CvID, pair.JdID, err)
+		}
+		moves[i] = ShadowMove{
+			CvID:           pair.CvID,
+			JdID:           pair.JdID,
+			BaselineScore:  base.WeightedScore,
+			CandidateScore: cand.WeightedScore,
+			ScoreDelta:     cand.WeightedScore - base.WeightedScore,
+		}
+		baseScores[i] = float64(base.WeightedScore)
+		candScores[i] = float64(cand.WeightedScore)
+	}
+
+	byJD := make(map[string][]int)
+	for i, move := range moves {
+		byJD[move.JdID] = append(byJD[move.JdID], i)
+	}
+	for _, members := range byJD {
+		rankWithin(moves, members, func(m ShadowMove) int { return m.BaselineScore }, func(m *ShadowMove, r int) { m.BaselineRank = r })
+		rankWithin(moves, members, func(m ShadowMove) int { return m.CandidateScore }, func(m *ShadowMove, r int) { m.CandidateRank = r })
+	}
+
+	report := &ShadowReport{
+		Pairs:     len(pairs),
+		JDs:       len(byJD),
+		Baseline:  distribution(baseScores),
+		Candidate: distribution(candScores),
+		Spearman:  Spearman(baseScores, candScores),
+		K:         k,
+	}
+	report.MeanShift = roundTenth(report.Candidate.Mean - report.Baseline.Mean)
+	for i := range moves {
+		move := &moves[i]
+		move.RankDelta = move.BaselineRank - move.CandidateRank
+		if move.RankDelta != 0 {
+			report.RankChanges++
+		}
+		if (move.BaselineRank <= k) != (move.CandidateRank <= k) {
+			report.TopKChanges++
+		}
+		if move.ScoreDelta != 0 {
+			report.ChangedPairs++
+		}
+		if d := abs(move.ScoreDelta); d > report.MaxMovement {
+			report.MaxMovement = d
+		}
+	}
+
+	sorted := append([]ShadowMove(nil), moves...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if a, b := abs(sorted[i].ScoreDelta), abs(sorted[j].ScoreDelta); a != b {
+			return a > b
+		}
+		return abs(sorted[i].RankDelta) > abs(sorted[j].RankDelta)
+	})
+	report.Movers = []ShadowMove{}
+	for _, move := range sorted {
+		if len(report.Movers) >= top || (move.ScoreDelta == 0 && move.RankDelta == 0) {
+			break
+		}
+		report.Movers = append(report.Movers, move)
+	}
+	return report, nil
+}
+
+// rankWithin assigns 1-based ranks to the members by descending score; equal scores share the better rank
+func rankWithin(moves []ShadowMove, members []int, score func(ShadowMove) int, set func(*ShadowMove, int)) {
+	order := append([]int(nil), members...)
+	sort.SliceStable(order, func(i, j int) bool { return score(moves[order[i]]) > score(moves[order[j]]) })
+	rank := 0
+	for i, idx := range order {
+		if i == 0 || score(moves[idx]) != score(moves[order[i-1]]) {
+			rank = i + 1
+		}
+		set(&moves[idx], rank)
+	}
+}
+
+// distribution summarizes scores
+func distribution(scores []float64) ScoreDistribution {
+	sorted := append([]float64(nil), scores...)
+	sort.Float64s(sorted)
+
+	mean := 0.0
+	for _, s := range sorted {
+		mean += s
+	}
+	mean /= float64(len(sorted))
+	variance := 0.0
+	for _, s := range sorted {
+		variance += (s - mean) * (s - mean)
+	}
+	variance /= float64(len(sorted))
+
+	// quantile uses the nearest rank
+	quantile := func(q float64) int {
+		idx := int(math.Ceil(q*float64(len(sorted)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return int(sorted[idx])
+	}
+	return ScoreDistribution{
+		Mean:   roundTenth(mean),
+		StdDev: roundTenth(math.Sqrt(variance)),
+		Min:    int(sorted[0]),
+		P25:    quantile(0.25),
+		Median: quantile(0.5),
+		P75:    quantile(0.75),
+		Max:    int(sorted[len(sorted)-1]),
+	}
+}
+
+// abs returns the absolute value of an int
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
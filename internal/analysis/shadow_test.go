@@ -0,0 +1,80 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCompareEngines(t *testing.T) {
+	jd := `# Backend Engineer
+
+## Requirements
+- Kubernetes
+- PostgreSQL
+- Docker`
+	pairs := []ShadowPair{
+		{CvID: "full", JdID: "backend", JD: jd, CV: "# Full\n\n## Skills\nKubernetes, PostgreSQL, Docker"},
+		{CvID: "partial", JdID: "backend", JD: jd, CV: "# Partial\n\n## Skills\nKubernetes"},
+		{CvID: "none", JdID: "backend", JD: jd, CV: "# None\n\n## Skills\nExcel"},
+	}
+	baseline := NewAnalysisEngine()
+
+	same, err := CompareEngines(context.Background(), baseline, baseline.Clone(), pairs, 1, 10)
+	if err != nil {
+		t.Fatalf("CompareEngines failed: %v", err)
+	}
+	if same.Pairs != 3 || same.JDs != 1 {
+		t.Errorf("Expected 3 pairs over 1 JD, got %d over %d", same.Pairs, same.JDs)
+	}
+	if same.RankChanges != 0 || same.ChangedPairs != 0 || same.MeanShift != 0 || len(same.Movers) != 0 {
+		t.Errorf("Expected no movement for identical engines, got %+v", same)
+	}
+	if same.Baseline != same.Candidate {
+		t.Errorf("Expected identical distributions, got %+v vs %+v", same.Baseline, same.Candidate)
+	}
+
+	// Dropping everything but proficiency flattens the scores
+	candidate := baseline.Clone().WithWeights(ScoringWeights{Proficiency: 1})
+	report, err := CompareEngines(context.Background(), baseline, candidate, pairs, 1, 2)
+	if err != nil {
+		t.Fatalf("CompareEngines failed: %v", err)
+	}
+	if report.ChangedPairs == 0 || report.MaxMovement == 0 {
+		t.Errorf("Expected scores to move, got %+v", report)
+	}
+	if len(report.Movers) == 0 || len(report.Movers) > 2 {
+		t.Fatalf("Expected 1-2 movers, got %d", len(report.Movers))
+	}
+	if abs(report.Movers[0].ScoreDelta) != report.MaxMovement {
+		t.Errorf("Expected the largest mover first, got %+v", report.Movers)
+	}
+	if !almostEqual(report.MeanShift, roundTenth(report.Candidate.Mean-report.Baseline.Mean), 0.001) {
+		t.Errorf("Expected the mean shift to be the difference of means, got %v", report.MeanShift)
+	}
+
+	if _, err := CompareEngines(context.Background(), baseline, candidate, nil, 1, 10); err == nil {
+		t.Error("Expected an error without pairs")
+	}
+}
+
+func TestRankWithin(t *testing.T) {
+	moves := []ShadowMove{{BaselineScore: 50}, {BaselineScore: 80}, {BaselineScore: 50}, {BaselineScore: 20}}
+	rankWithin(moves, []int{0, 1, 2, 3}, func(m ShadowMove) int { return m.BaselineScore }, func(m *ShadowMove, r int) { m.BaselineRank = r })
+
+	want := []int{2, 1, 2, 4}
+	for i, move := range moves {
+		if move.BaselineRank != want[i] {
+			t.Errorf("Move %d: expected rank %d, got %d", i, want[i], move.BaselineRank)
+		}
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	d := distribution([]float64{40, 10, 30, 20})
+	if d.Min != 10 || d.Max != 40 || d.Median != 20 || d.P25 != 10 || d.P75 != 30 {
+		t.Errorf("Unexpected quantiles: %+v", d)
+	}
+	if !almostEqual(d.Mean, 25, 0.001) || !almostEqual(d.StdDev, 11.2, 0.001) {
+		t.Errorf("Unexpected mean or spread: %+v", d)
+	}
+}
@@ -0,0 +1,136 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Score edit kinds
+const (
+	EditAddSkill    = "add_skill"
+	EditRemoveSkill = "remove_skill"
+	EditSetYears    = "set_years"
+)
+
+// ScoreEdit is a hypothetical change to a CV, such as "add skill Kubernetes with 2 years"
+type ScoreEdit struct {
+	Kind  string `json:"kind"`
+	Skill string `json:"skill,omitempty"`
+	Years int    `json:"years,omitempty"`
+}
+
+// String returns the edit in expression form
+func (e ScoreEdit) String() string {
+	switch e.Kind {
+	case EditAddSkill:
+		if e.Years > 0 {
+			return fmt.Sprintf("add skill %s with %d years", e.Skill, e.Years)
+		}
+		return "add skill " + e.Skill
+	case EditRemoveSkill:
+		return "remove " + e.Skill
+	case EditSetYears:
+		return fmt.Sprintf("set total experience to %d years", e.Years)
+	}
+	return e.Kind
+}
+
+var (
+	// addEditPattern matches "add skill Kubernetes with 2 years", "add Kafka (3 years)", "add Rust"
+	addEditPattern = regexp.MustCompile(`(?i)^add\s+(?:skill\s+)?(.+?)(?:\s*(?:with|for|,|\()\s*(\d+)\+?\s*(?:years?|yrs?)\)?)?$`)
+
+	// removeEditPattern matches "remove PHP", "drop skill jQuery"
+	removeEditPattern = regexp.MustCompile(`(?i)^(?:remove|drop)\s+(?:skill\s+)?(.+)$`)
+
+	// setYearsEditPattern matches "set total experience to 6 years", "set experience = 6"
+	setYearsEditPattern = regexp.MustCompile(`(?i)^set\s+(?:total\s+)?(?:experience|years)\s*(?:to|=)?\s*(\d+)\s*(?:years?|yrs?)?$`)
+
+	// statedYearsPattern matches a stated career length such as "6 years of experience" or "8+ years"
+	statedYearsPattern = regexp.MustCompile(`(?i)\b\d+\+?\s*(?:years?|yrs?)(?:\s+of\s+(?:professional\s+)?experience)?`)
+)
+
+// ParseScoreEdits parses one edit expression per entry. Skills must be in the dictionary.
+func ParseScoreEdits(lines []string, dict *SkillsDictionary) ([]ScoreEdit, error) {
+	edits := make([]ScoreEdit, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var edit ScoreEdit
+		if m := setYearsEditPattern.FindStringSubmatch(line); m != nil {
+			years, _ := strconv.Atoi(m[1])
+			if years <= 0 {
+				return nil, fmt.Errorf("edit %q: years must be positive", line)
+			}
+			edit = ScoreEdit{Kind: EditSetYears, Years: years}
+		} else if m := removeEditPattern.FindStringSubmatch(line); m != nil {
+			edit = ScoreEdit{Kind: EditRemoveSkill, Skill: strings.TrimSpace(m[1])}
+		} else if m := addEditPattern.FindStringSubmatch(line); m != nil {
+			edit = ScoreEdit{Kind: EditAddSkill, Skill: strings.TrimSpace(m[1])}
+			if m[2] != "" {
+				edit.Years, _ = strconv.Atoi(m[2])
+			}
+		} else {
+			return nil, fmt.Errorf("edit %q: expected \"add skill X [with N years]\", \"remove X\" or \"set total experience to N years\"", line)
+		}
+
+		if edit.Skill != "" {
+			if _, found := dict.FindSkill(edit.Skill); !found {
+				return nil, fmt.Errorf("edit %q: unknown skill %q", line, edit.Skill)
+			}
+		}
+		edits = append(edits, edit)
+	}
+	return edits, nil
+}
+
+// ApplyScoreEdits returns a copy of the CV with the edits applied to its text: added skills are
+// listed in the skills section with their years, removed skills are deleted wherever they are
+// mentioned, and a total experience edit replaces (or adds) the summary's stated years
+func ApplyScoreEdits(cvContent string, edits []ScoreEdit) string {
+	for _, edit := range edits {
+		cv := parse.ParseCV(cvContent)
+		switch edit.Kind {
+		case EditAddSkill:
+			line := edit.Skill
+			if edit.Years > 0 {
+				line = fmt.Sprintf("%s (%d years)", edit.Skill, edit.Years)
+			}
+			cvContent = appendToSection(cvContent, cv, parse.SectionSkills, "Skills", line)
+		case EditRemoveSkill:
+			pattern := regexp.MustCompile(`(?i)(^|[^\p{L}\p{N}])` + regexp.QuoteMeta(edit.Skill) + `([^\p{L}\p{N}]|$)`)
+			// Matches share boundary characters, so repeat until adjacent mentions are gone too
+			for pattern.MatchString(cvContent) {
+				cvContent = pattern.ReplaceAllString(cvContent, "$1$2")
+			}
+		case EditSetYears:
+			stated := fmt.Sprintf("%d years of experience", edit.Years)
+			if summary := cv.SectionText(parse.SectionSummary); summary != "" && statedYearsPattern.MatchString(summary) {
+				cvContent = strings.Replace(cvContent, summary, statedYearsPattern.ReplaceAllString(summary, stated), 1)
+			} else {
+				cvContent = appendToSection(cvContent, cv, parse.SectionSummary, "Summary", stated+".")
+			}
+		}
+	}
+	return cvContent
+}
+
+// Simulate scores a CV as if the edits had been made, without touching the stored document.
+// A total experience edit sets the career length stated in the summary; no skill can have been
+// used for longer, so it also caps the years credited for each skill, but never raises them.
+func (e *AnalysisEngine) Simulate(ctx context.Context, cvContent, jdContent string, edits []ScoreEdit) (*AnalysisResult, error) {
+	opts := analysisOptions{}
+	for _, edit := range edits {
+		if edit.Kind == EditSetYears {
+			opts.totalYears = edit.Years
+		}
+	}
+	return e.analyze(ctx, ApplyScoreEdits(cvContent, edits), jdContent, nil, opts)
+}
@@ -0,0 +1,103 @@
+package analysis
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseScoreEdits(t *testing.T) {
+	edits, err := ParseScoreEdits([]string{
+		"add skill Kubernetes with 2 years",
+		"add Kafka (3 years)",
+		"add Rust",
+		"remove PHP",
+		"set total experience to 6 years",
+		"  ",
+	}, NewSkillsDictionary())
+	if err != nil {
+		t.Fatalf("ParseScoreEdits failed: %v", err)
+	}
+
+	want := []ScoreEdit{
+		{Kind: EditAddSkill, Skill: "Kubernetes", Years: 2},
+		{Kind: EditAddSkill, Skill: "Kafka", Years: 3},
+		{Kind: EditAddSkill, Skill: "Rust"},
+		{Kind: EditRemoveSkill, Skill: "PHP"},
+		{Kind: EditSetYears, Years: 6},
+	}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("Expected %+v, got %+v", want, edits)
+	}
+	if got := want[0].String(); got != "add skill Kubernetes with 2 years" {
+		t.Errorf("Expected the expression form, got %q", got)
+	}
+
+	for _, line := range []string{"add skill Underwater Basket Weaving", "promote to lead", "set total experience to 0 years"} {
+		if _, err := ParseScoreEdits([]string{line}, NewSkillsDictionary()); err == nil {
+			t.Errorf("Expected an error for %q", line)
+		}
+	}
+}
+
+func TestApplyScoreEdits(t *testing.T) {
+	cv := "# Jane\n\n## Summary\nDeveloper with 3 years of experience.\n\n## Skills\nGo, PHP, Docker\n"
+
+	got := ApplyScoreEdits(cv, []ScoreEdit{
+		{Kind: EditAddSkill, Skill: "Kubernetes", Years: 2},
+		{Kind: EditRemoveSkill, Skill: "php"},
+		{Kind: EditSetYears, Years: 6},
+	})
+
+	if !strings.Contains(got, "Kubernetes (2 years)") {
+		t.Errorf("Expected the added skill with years, got %q", got)
+	}
+	if strings.Contains(strings.ToLower(got), "php") {
+		t.Errorf("Expected PHP to be removed, got %q", got)
+	}
+	if !strings.Contains(got, "Developer with 6 years of experience.") {
+		t.Errorf("Expected the stated years to be replaced, got %q", got)
+	}
+}
+
+func TestEngine_Simulate(t *testing.T) {
+	engine := NewAnalysisEngine()
+	cv := "# Jane\n\n## Skills\nGo, PHP\n"
+	jd := "# Platform Engineer\n\n## Requirements\n- 5+ years of Go\n- Kubernetes\n"
+
+	base, err := engine.Analyze(context.Background(), cv, jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+
+	added, err := engine.Simulate(context.Background(), cv, jd, []ScoreEdit{{Kind: EditAddSkill, Skill: "Kubernetes", Years: 2}})
+	if err != nil {
+		t.Fatalf("Simulate failed: %v", err)
+	}
+	if added.SkillCoverage <= base.SkillCoverage || added.WeightedScore <= base.WeightedScore {
+		t.Errorf("Expected adding Kubernetes to raise coverage and score, got %v/%d vs %v/%d",
+			added.SkillCoverage, added.WeightedScore, base.SkillCoverage, base.WeightedScore)
+	}
+
+	senior, err := engine.Simulate(context.Background(), cv, jd, []ScoreEdit{{Kind: EditSetYears, Years: 6}})
+	if err != nil {
+		t.Fatalf("Simulate failed: %v", err)
+	}
+	if senior.ExperienceMatch != base.ExperienceMatch {
+		t.Errorf("Expected a career length edit not to credit skills with years, got %v vs %v", senior.ExperienceMatch, base.ExperienceMatch)
+	}
+
+	veteran := "# Jane\n\n## Skills\nGo (8 years), PHP\n"
+	stated, err := engine.Analyze(context.Background(), veteran, jd)
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	junior, err := engine.Simulate(context.Background(), veteran, jd, []ScoreEdit{{Kind: EditSetYears, Years: 2}})
+	if err != nil {
+		t.Fatalf("Simulate failed: %v", err)
+	}
+	if junior.ExperienceMatch >= stated.ExperienceMatch {
+		t.Errorf("Expected a shorter career to cap the years of Go, got %v vs %v", junior.ExperienceMatch, stated.ExperienceMatch)
+	}
+}
@@ -3,6 +3,8 @@ package analysis
 import (
 	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -11,20 +13,47 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"unicode"
 )
 
 // Skill represents a detected skill from CV/JD content
 type Skill struct {
 	Name       string  `json:"name"`       // Skill name (e.g., "Go", "Python")
 	Category   string  `json:"category"`   // Category (e.g., "language", "framework", "database")
-	Experience int     `json:"experience"` // Years mentioned (0 if not specified)
+	Experience int     `json:"experience"` // Years mentioned, or the lower bound of a range (0 if not specified)
 	Confidence float64 `json:"confidence"` // 0.0-1.0 confidence score
+	// ExperienceMax is the upper bound of a "3-5 years" style range (0 if open-ended)
+	ExperienceMax int `json:"experience_max,omitempty"`
+	// LastUsed is the year of the latest CV role mentioning the skill (0 if unknown)
+	LastUsed int `json:"last_used,omitempty"`
+	// Recency is the 0.0-1.0 discount for skills not used recently (0 if not computed)
+	Recency float64 `json:"recency,omitempty"`
+	// Negated is set when every mention is negated ("no experience with Java", "PHP не требуется")
+	Negated bool `json:"negated,omitempty"`
+	// Version is the newest version or generation mentioned ("17", "3.11", "2+" for "or newer")
+	Version string `json:"version,omitempty"`
+	// VersionMatch is how a matched CV version compares with the JD requirement (see VersionExact)
+	VersionMatch string `json:"version_match,omitempty"`
+	// Proficiency is the highest stated level ("expert in Go", "basic SQL"), see ProficiencyBasic
+	Proficiency string `json:"proficiency,omitempty"`
+	// Requirement is "required" or "preferred" for skills from a structured JD
+	Requirement string `json:"requirement,omitempty"`
 }
 
+// Skill requirement levels taken from structured job descriptions
+const (
+	RequirementRequired  = "required"
+	RequirementPreferred = "preferred"
+)
+
+// preferredSkillWeight is the coverage weight of a nice-to-have skill relative to a required one
+const preferredSkillWeight = 0.5
+
 // SkillsDictionary provides skill matching capabilities
 type SkillsDictionary struct {
 	skillsByCategory map[string][]string
 	skillIndex       map[string]string // normalized skill name -> category
+	displayNames     map[string]string // normalized skill name -> name as written in the dictionary
 	once             sync.Once
 }
 
@@ -33,6 +62,7 @@ func NewSkillsDictionary() *SkillsDictionary {
 	sd := &SkillsDictionary{
 		skillsByCategory: make(map[string][]string),
 		skillIndex:       make(map[string]string),
+		displayNames:     make(map[string]string),
 	}
 	sd.loadDictionary()
 	return sd
@@ -86,11 +116,25 @@ func (sd *SkillsDictionary) loadDictionary() {
 				// Index by normalized name
 				normalized := strings.ToLower(line)
 				sd.skillIndex[normalized] = currentCategory
+				if _, ok := sd.displayNames[normalized]; !ok {
+					sd.displayNames[normalized] = line
+				}
 			}
 		}
 	})
 }
 
+// Checksum returns a hash of the loaded dictionary, so results computed with another dictionary can be told apart
+func (sd *SkillsDictionary) Checksum() string {
+	names := make([]string, 0, len(sd.skillIndex))
+	for name, category := range sd.skillIndex {
+		names = append(names, name+"\t"+category)
+	}
+	sort.Strings(names)
+	hash := sha256.Sum256([]byte(strings.Join(names, "\n")))
+	return hex.EncodeToString(hash[:8])
+}
+
 // FindSkill looks up a skill in the dictionary and returns category if found
 func (sd *SkillsDictionary) FindSkill(skillName string) (category string, found bool) {
 	normalized := strings.ToLower(strings.TrimSpace(skillName))
@@ -98,6 +142,52 @@ func (sd *SkillsDictionary) FindSkill(skillName string) (category string, found
 	return
 }
 
+// DisplayName returns a skill as the dictionary writes it ("C", "PostgreSQL"), or the name as
+// given when the dictionary does not list it
+func (sd *SkillsDictionary) DisplayName(skillName string) string {
+	if name, ok := sd.displayNames[strings.ToLower(strings.TrimSpace(skillName))]; ok {
+		return name
+	}
+	return skillName
+}
+
+// skillAliases maps dictionary entries that name the same skill to one canonical entry. Skill
+// extraction and screening rules both resolve through it, so scoring and screening agree.
+var skillAliases = map[string]string{
+	"golang":                       "go",
+	"k8s":                          "kubernetes",
+	"postgres":                     "postgresql",
+	"amazon web services":          "aws",
+	"google cloud platform":        "gcp",
+	"microsoft azure":              "azure",
+	"test-driven development":      "tdd",
+	"behavior-driven development":  "bdd",
+	"site reliability engineering": "sre",
+	"openid connect":               "oidc",
+}
+
+// Canonical returns the lowercase canonical name of a skill, resolving aliases such as "k8s" to
+// "kubernetes"; names outside the alias table are only normalized. It is safe on a nil dictionary.
+func (sd *SkillsDictionary) Canonical(skillName string) string {
+	normalized := strings.ToLower(strings.TrimSpace(skillName))
+	if canonical, ok := skillAliases[normalized]; ok {
+		return canonical
+	}
+	return normalized
+}
+
+// aliasesOf returns the alias spellings of a canonical skill name, sorted
+func aliasesOf(skillName string) []string {
+	var aliases []string
+	for alias, canonical := range skillAliases {
+		if canonical == skillName {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
 // ExtractSkills extracts skills from content using dictionary matching
 // This simulates LLM-based langextractor by using dictionary-based matching
 // with additional context analysis
@@ -108,32 +198,35 @@ func ExtractSkills(ctx context.Context, content string, dict *SkillsDictionary)
 		dict = NewSkillsDictionary()
 	}
 
+	// Classify every mention by its sentence context; ambiguous entries ("go", "spring")
+	// only count where the original text uses them as technologies
+	mentions := findSkillMentions(content, func(word string) bool {
+		_, found := dict.FindSkill(word)
+		return found
+	})
 	content = strings.ToLower(content)
-	words := tokenizeContent(content)
 
-	// Track seen skills to avoid duplicates
-	seen := make(map[string]bool)
 	var skills []Skill
-
-	// Extract skills from words
-	for _, word := range words {
-		// Check if word is in dictionary
-		category, found := dict.FindSkill(word)
-		if found && !seen[word] {
-			// Calculate confidence based on context
-			confidence := calculateConfidence(word, content)
-			experience := extractExperience(word, content)
-
-			skill := Skill{
-				Name:       word,
-				Category:   category,
-				Experience: experience,
-				Confidence: confidence,
+	for word, wordMentions := range mentions {
+		category, _ := dict.FindSkill(word)
+		experience := extractExperience(word, content)
+		for _, alias := range aliasesOf(word) {
+			if experience.Min > 0 {
+				break
 			}
-
-			skills = append(skills, skill)
-			seen[word] = true
+			experience = extractExperience(alias, content)
 		}
+
+		skills = append(skills, Skill{
+			Name:          word,
+			Category:      category,
+			Experience:    experience.Min,
+			ExperienceMax: experience.Max,
+			Confidence:    contextConfidence(wordMentions),
+			Negated:       mentionsNegated(wordMentions),
+			Version:       mentionsVersion(wordMentions),
+			Proficiency:   mentionsProficiency(wordMentions),
+		})
 	}
 
 	// Sort by confidence descending
@@ -189,105 +282,89 @@ func tokenizeContent(content string) []string {
 	return words
 }
 
-// calculateConfidence calculates confidence score for a skill
-// This uses heuristics to simulate LLM-based confidence scoring
-func calculateConfidence(skill, content string) float64 {
-	baseConfidence := 0.5
-
-	// Boost confidence if skill appears multiple times
-	count := strings.Count(content, skill)
-	if count > 0 {
-		baseConfidence += float64(count) * 0.1
-	}
-
-	// Boost confidence if in skill-specific context
-	contexts := []string{
-		"experience with", "proficient in", "skilled in", "knowledge of",
-		"worked with", "built using", "developed with", "implemented using",
-	}
-	for _, ctx := range contexts {
-		if strings.Contains(content, ctx+" "+skill) {
-			baseConfidence += 0.2
+// extractExperience extracts the years of experience stated for a skill.
+// It picks the experience phrase closest to the skill on the same line, such as
+// "5+ years of go experience", "go (3-5 years)" or "go: более 5 лет"
+func extractExperience(skill, content string) ExperienceRange {
+	for _, line := range strings.Split(content, "\n") {
+		if !strings.Contains(line, skill) {
+			continue
+		}
+		phrases := findExperience(line)
+		if len(phrases) == 0 {
+			continue
 		}
-	}
-
-	// Cap at 1.0
-	if baseConfidence > 1.0 {
-		baseConfidence = 1.0
-	}
-
-	return baseConfidence
-}
 
-// extractExperience extracts years of experience for a skill
-// Looks for patterns like "5 years of Go experience" or "Go (3 years)"
-func extractExperience(skill, content string) int {
-	// Extract numeric patterns near the skill
-	lines := strings.Split(content, "\n")
-	for _, line := range lines {
-		if strings.Contains(line, skill) {
-			// Look for years pattern
-			if strings.Contains(line, "year") {
-				words := strings.Fields(line)
-				for i, word := range words {
-					if word == skill {
-						// Check previous few words for number
-						// Pattern: "5 years of go", "go with 3 years"
-						start := i - 5
-						if start < 0 {
-							start = 0
-						}
-						for k := start; k < i; k++ {
-							if years := parseInt(words[k]); years > 0 {
-								return years
-							}
-						}
-						// Check next few words
-						end := i + 5
-						if end > len(words) {
-							end = len(words)
-						}
-						for j := i + 1; j < end; j++ {
-							if years := parseInt(words[j]); years > 0 {
-								return years
-							}
-						}
-					}
+		spans := wordSpans(line)
+		best, bestDistance := ExperienceRange{}, experienceWindow+1
+		for i, span := range spans {
+			if line[span[0]:span[1]] != skill {
+				continue
+			}
+			for _, phrase := range phrases {
+				if distance := wordDistance(spans, i, phrase.Start, phrase.End); distance < bestDistance {
+					best, bestDistance = phrase.Range, distance
 				}
 			}
 		}
+		if bestDistance <= experienceWindow {
+			return best
+		}
 	}
 
-	return 0 // No experience specified
+	return ExperienceRange{} // No experience specified
 }
 
-// parseInt extracts first integer from a string
-func parseInt(s string) int {
-	s = strings.TrimSpace(s)
-	var numStr strings.Builder
-	for _, r := range s {
-		if r >= '0' && r <= '9' {
-			numStr.WriteRune(r)
-		} else if numStr.Len() > 0 {
-			break
+// wordSpans returns the byte offsets of the words in line, split the same way as tokenizeContent
+func wordSpans(line string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range line {
+		delimiter := unicode.IsSpace(r) || strings.ContainsRune(",;.:()[]{}", r)
+		if delimiter && start >= 0 {
+			spans = append(spans, [2]int{start, i})
+			start = -1
+		} else if !delimiter && start < 0 {
+			start = i
 		}
 	}
-
-	if numStr.Len() == 0 {
-		return 0
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(line)})
 	}
+	return spans
+}
 
-	var result int
-	for _, r := range numStr.String() {
-		result = result*10 + int(r-'0')
+// wordDistance counts the words between word index i and the byte range [start, end)
+func wordDistance(spans [][2]int, i, start, end int) int {
+	first, last := len(spans), -1
+	for j, span := range spans {
+		if span[1] > start && span[0] < end {
+			if j < first {
+				first = j
+			}
+			last = j
+		}
+	}
+	switch {
+	case last < 0:
+		return len(spans)
+	case i < first:
+		return first - i
+	case i > last:
+		return i - last
+	default:
+		return 0
 	}
-	return result
 }
 
 // MatchSkills compares CV skills against JD skills
 func MatchSkills(cvSkills, jdSkills []Skill) (matches []Skill, missing []Skill, partialMatches []Skill) {
 	cvIndex := make(map[string]Skill)
 	for _, skill := range cvSkills {
+		// "No experience with Java" is not a Java match
+		if skill.Negated {
+			continue
+		}
 		cvIndex[skill.Name] = skill
 	}
 
@@ -302,10 +379,17 @@ func MatchSkills(cvSkills, jdSkills []Skill) (matches []Skill, missing []Skill,
 			// Calculate match confidence
 			matchConfidence := (cvSkill.Confidence + jdSkill.Confidence) / 2
 			matchedSkill := Skill{
-				Name:       jdSkill.Name,
-				Category:   jdSkill.Category,
-				Experience: cvSkill.Experience,
-				Confidence: matchConfidence,
+				Name:          jdSkill.Name,
+				Category:      jdSkill.Category,
+				Experience:    cvSkill.Experience,
+				ExperienceMax: cvSkill.ExperienceMax,
+				Confidence:    matchConfidence,
+				Requirement:   jdSkill.Requirement,
+				LastUsed:      cvSkill.LastUsed,
+				Recency:       cvSkill.Recency,
+				Version:       cvSkill.Version,
+				VersionMatch:  CompareVersions(jdSkill.Name, cvSkill.Version, jdSkill.Version),
+				Proficiency:   cvSkill.Proficiency,
 			}
 			matches = append(matches, matchedSkill)
 		} else {
@@ -58,6 +58,52 @@ func TestSkillsDictionary_Lookup(t *testing.T) {
 	}
 }
 
+func TestSkillsDictionary_Canonical(t *testing.T) {
+	sd := NewSkillsDictionary()
+
+	tests := map[string]string{
+		"Golang":              "go",
+		" K8s ":               "kubernetes",
+		"postgres":            "postgresql",
+		"Amazon Web Services": "aws",
+		"Terraform":           "terraform",
+	}
+	for name, want := range tests {
+		if got := sd.Canonical(name); got != want {
+			t.Errorf("Canonical(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	var nilDict *SkillsDictionary
+	if got := nilDict.Canonical("Golang"); got != "go" {
+		t.Errorf("Expected a nil dictionary to still resolve aliases, got %q", got)
+	}
+}
+
+func TestExtractSkills_Aliases(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	cvSkills := ExtractSkills(ctx, "Backend developer: Golang microservices on K8s (3 years).", sd)
+	jdSkills := ExtractSkills(ctx, "We need Go and Kubernetes experience.", sd)
+
+	names := make(map[string]Skill)
+	for _, skill := range cvSkills {
+		names[skill.Name] = skill
+	}
+	if _, ok := names["golang"]; ok {
+		t.Errorf("Expected golang to be extracted as go, got %+v", cvSkills)
+	}
+	if names["kubernetes"].Experience != 3 {
+		t.Errorf("Expected the years stated for k8s to count for kubernetes, got %+v", names["kubernetes"])
+	}
+
+	matches, missing, _ := MatchSkills(cvSkills, jdSkills)
+	if len(matches) != 2 || len(missing) != 0 {
+		t.Errorf("Expected aliases to match go and kubernetes, got matches %+v, missing %+v", matches, missing)
+	}
+}
+
 func TestExtractSkills_SingleSkill(t *testing.T) {
 	ctx := context.Background()
 	sd := NewSkillsDictionary()
@@ -122,6 +168,12 @@ func TestExtractSkills_ExperienceExtraction(t *testing.T) {
 		{"go (2 years)", "go", 2},
 		{"java developer, 8 years", "java", 8},
 		{"react developer", "react", 0}, // No years specified
+		{"5+ years of go experience", "go", 5},
+		{"five years building python services", "python", 5},
+		{"over a decade of java", "java", 10},
+		{"go: более 5 лет", "go", 5},
+		{"python — 3 года опыта", "python", 3},
+		{"java 17, spring boot", "java", 0}, // Version number, not years
 	}
 
 	for _, tt := range tests {
@@ -317,28 +369,6 @@ func TestTokenizeContent(t *testing.T) {
 	}
 }
 
-func TestParseInt(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected int
-	}{
-		{"5", 5},
-		{"123", 123},
-		{"5 years", 5},
-		{"2+", 2},
-		{"eight", 0},
-		{"", 0},
-		{"  7  ", 7},
-	}
-
-	for _, tt := range tests {
-		result := parseInt(tt.input)
-		if result != tt.expected {
-			t.Errorf("parseInt(%q) = %d, want %d", tt.input, result, tt.expected)
-		}
-	}
-}
-
 func TestLoadSkillsDictionary(t *testing.T) {
 	skills, err := LoadSkillsDictionary()
 	if err != nil {
@@ -371,6 +401,7 @@ func TestSkillsDictionary_LoadNotFound(t *testing.T) {
 	_sd := &SkillsDictionary{
 		skillsByCategory: make(map[string][]string),
 		skillIndex:       make(map[string]string),
+		displayNames:     make(map[string]string),
 	}
 	// This should not panic even if file is missing
 	_sd.loadDictionary()
@@ -0,0 +1,213 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// Suggestion kinds
+const (
+	SuggestMissingRequirement = "missing_requirement"
+	SuggestUnquantifiedClaim  = "unquantified_claim"
+	SuggestWeakSection        = "weak_section"
+)
+
+// maxClaimSuggestions caps unquantified claim suggestions, each of which costs a scoring run
+const maxClaimSuggestions = 5
+
+// quantifiedPattern matches evidence of scale or outcome: numbers, percentages, currency amounts
+var quantifiedPattern = regexp.MustCompile(`\d|%|[$€£₽]`)
+
+// Suggestion is one concrete CV improvement with its simulated effect on the score
+type Suggestion struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target"` // Skill, experience bullet or section the suggestion is about
+	Issue  string `json:"issue"`
+	Hint   string `json:"hint"`
+	// ScoreImpact is the weighted score change when the hint is applied to a simulated CV
+	ScoreImpact int `json:"score_impact"`
+	// Simulated is false for hints that cannot be applied to a CV faithfully, such as inventing a
+	// measurable outcome; their ScoreImpact is always 0
+	Simulated bool `json:"simulated"`
+}
+
+// SuggestImprovements lists deterministic CV improvements for a JD: dictionary skills the JD asks
+// for and the CV does not mention, experience bullets without quantified evidence and missing sections. Each suggestion is
+// scored by re-running the analysis on a copy of the CV with the hint applied. Suggestions are
+// ordered by score impact; the returned result is the analysis of the CV as is.
+func (e *AnalysisEngine) SuggestImprovements(ctx context.Context, cvContent, jdContent string) ([]Suggestion, *AnalysisResult, error) {
+	base, err := e.Analyze(ctx, cvContent, jdContent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cv := parse.ParseCV(cvContent)
+	jd := parse.ParseJD(jdContent)
+	var suggestions []Suggestion
+
+	// simulate scores the CV with one change applied
+	simulate := func(simulated string) (int, error) {
+		result, err := e.Analyze(ctx, simulated, jdContent)
+		if err != nil {
+			return 0, err
+		}
+		return result.WeightedScore - base.WeightedScore, nil
+	}
+	add := func(s Suggestion, simulated string) error {
+		impact, err := simulate(simulated)
+		if err != nil {
+			return fmt.Errorf("simulate %s suggestion: %w", s.Kind, err)
+		}
+		s.ScoreImpact = impact
+		s.Simulated = true
+		suggestions = append(suggestions, s)
+		return nil
+	}
+
+	dict := NewSkillsDictionary()
+	for _, skill := range MissingRequirements(ctx, cvContent, jdContent) {
+		name := dict.DisplayName(skill)
+		err := add(Suggestion{
+			Kind:   SuggestMissingRequirement,
+			Target: skill,
+			Issue:  fmt.Sprintf("The job description asks for %s, which the CV does not mention", name),
+			Hint:   fmt.Sprintf("If you have used %s, add a bullet under the role where you used it (e.g. \"- Built ... with %s, ...\") and list it under Skills", name, name),
+		}, appendToSection(cvContent, cv, parse.SectionExperience, "Experience", simulatedMention(skill, name)))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Scoring does not reward stated outcomes, so these hints are not simulated
+	claims := 0
+	for _, entry := range cv.Experience {
+		for _, bullet := range entry.Bullets {
+			if claims >= maxClaimSuggestions || quantifiedPattern.MatchString(bullet) {
+				continue
+			}
+			claims++
+			suggestions = append(suggestions, Suggestion{
+				Kind:   SuggestUnquantifiedClaim,
+				Target: bullet,
+				Issue:  fmt.Sprintf("No measurable outcome in a %s bullet", roleName(entry)),
+				Hint:   "Add what changed and by how much: a percentage, amount, team size or scale (e.g. \"..., cutting p95 latency by 40%\")",
+			})
+		}
+	}
+
+	var present []string
+	for _, skill := range base.PresentSkills {
+		if len(present) == 5 {
+			break
+		}
+		present = append(present, dict.DisplayName(skill))
+	}
+	if cv.SectionText(parse.SectionSummary) == "" {
+		title := jd.Title
+		if title == "" {
+			title = "Professional"
+		}
+		line := title
+		if len(present) > 0 {
+			line += " experienced in " + strings.Join(present, ", ")
+		}
+		err := add(Suggestion{
+			Kind:   SuggestWeakSection,
+			Target: string(parse.SectionSummary),
+			Issue:  "The CV has no summary",
+			Hint:   fmt.Sprintf("Open with a two-line summary naming the role and your strongest matching skills (e.g. \"%s\")", line),
+		}, appendToSection(cvContent, cv, parse.SectionSummary, "Summary", line))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(cv.Skills) == 0 && len(present) > 0 {
+		err := add(Suggestion{
+			Kind:   SuggestWeakSection,
+			Target: string(parse.SectionSkills),
+			Issue:  "The CV has no skills section",
+			Hint:   fmt.Sprintf("Add a Skills section listing the technologies you use (e.g. %s)", strings.Join(present, ", ")),
+		}, appendToSection(cvContent, cv, parse.SectionSkills, "Skills", strings.Join(present, ", ")))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, entry := range cv.Experience {
+		if len(entry.Bullets) == 0 && entry.Title != "" {
+			suggestions = append(suggestions, Suggestion{
+				Kind:   SuggestWeakSection,
+				Target: roleName(entry),
+				Issue:  fmt.Sprintf("The %s role has no bullets describing the work", roleName(entry)),
+				Hint:   "Add two to four bullets on what you built or owned in this role, with the technologies used and the outcome",
+			})
+		}
+	}
+
+	// Simulated suggestions first, by impact
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Simulated != suggestions[j].Simulated {
+			return suggestions[i].Simulated
+		}
+		return suggestions[i].ScoreImpact > suggestions[j].ScoreImpact
+	})
+	return suggestions, base, nil
+}
+
+// simulatedMention is the experience bullet a missing skill is simulated with. Strict entries
+// (C, R) only count in technical context, so the bullet names one of their context words.
+func simulatedMention(skill, name string) string {
+	if rule, ok := ambiguousSkills[skill]; ok && rule.Strict && len(rule.Context) > 0 {
+		return fmt.Sprintf("- Built %s services with %s", rule.Context[0], name)
+	}
+	return "- Built services with " + name
+}
+
+// roleName formats an experience entry as "Title at Company"
+func roleName(entry parse.ExperienceEntry) string {
+	if entry.Company == "" {
+		return entry.Title
+	}
+	return entry.Title + " at " + entry.Company
+}
+
+// appendToSection returns the CV with a line added at the end of the first section of a kind,
+// or in a new section with the given heading when the CV has none
+func appendToSection(content string, cv *parse.CV, kind parse.SectionKind, heading, line string) string {
+	for _, section := range cv.Sections {
+		if section.Kind != kind || section.Content == "" {
+			continue
+		}
+		if i := strings.Index(content, section.Content); i >= 0 {
+			end := i + len(section.Content)
+			return content[:end] + "\n" + line + content[end:]
+		}
+	}
+	return strings.TrimRight(content, "\n") + "\n\n## " + heading + "\n" + line + "\n"
+}
+
+// MissingRequirements returns the dictionary skills the JD asks for that the CV lacks, matched as the
+// analysis matches them. BM25's missing terms are not used: they include words like "looking" or
+// "passionate", and every suggestion costs a scoring run.
+func MissingRequirements(ctx context.Context, cvContent, jdContent string) []string {
+	jdText := parse.ParseJD(jdContent).ScoringText()
+	if strings.TrimSpace(jdText) == "" {
+		jdText = jdContent
+	}
+	dict := NewSkillsDictionary()
+	_, missing, _ := MatchSkills(ExtractSkills(ctx, cvContent, dict), withoutNegated(ExtractSkills(ctx, jdText, dict)))
+
+	names := make([]string, 0, len(missing))
+	seen := make(map[string]bool)
+	for _, skill := range missing {
+		if !seen[skill.Name] {
+			seen[skill.Name] = true
+			names = append(names, skill.Name)
+		}
+	}
+	return names
+}
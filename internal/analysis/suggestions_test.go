@@ -0,0 +1,137 @@
+package analysis
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+func TestEngine_SuggestImprovements(t *testing.T) {
+	cv := `# Jane Doe
+
+## Experience
+### Backend Developer - Acme
+2020 - Present
+- Built REST APIs in Go
+- Cut deployment time by 40% with Docker
+
+### Intern - Initech
+2019 - 2020
+`
+	jd := "# Platform Engineer\n\n## Requirements\n- Go\n- Docker\n- Kubernetes\n"
+
+	suggestions, base, err := NewAnalysisEngine().SuggestImprovements(context.Background(), cv, jd)
+	if err != nil {
+		t.Fatalf("SuggestImprovements failed: %v", err)
+	}
+	if base == nil {
+		t.Fatal("Expected the base analysis")
+	}
+
+	byTarget := make(map[string]Suggestion)
+	for _, s := range suggestions {
+		if s.Issue == "" || s.Hint == "" {
+			t.Errorf("Expected an issue and a hint, got %+v", s)
+		}
+		byTarget[s.Kind+":"+s.Target] = s
+	}
+
+	missing, ok := byTarget[SuggestMissingRequirement+":kubernetes"]
+	if !ok {
+		t.Fatalf("Expected a missing requirement suggestion for kubernetes, got %+v", suggestions)
+	}
+	if missing.ScoreImpact <= 0 {
+		t.Errorf("Expected mentioning kubernetes to raise the score, got %d", missing.ScoreImpact)
+	}
+	claim, ok := byTarget[SuggestUnquantifiedClaim+":Built REST APIs in Go"]
+	if !ok {
+		t.Errorf("Expected the unquantified bullet to be flagged, got %+v", suggestions)
+	}
+	if claim.Simulated || claim.ScoreImpact != 0 {
+		t.Errorf("Expected no simulated impact for an unquantified claim, got %+v", claim)
+	}
+	for key := range byTarget {
+		if strings.Contains(key, "40%") {
+			t.Errorf("Expected the quantified bullet not to be flagged, got %s", key)
+		}
+	}
+	for _, key := range []string{SuggestWeakSection + ":summary", SuggestWeakSection + ":skills", SuggestWeakSection + ":Intern at Initech"} {
+		if _, ok := byTarget[key]; !ok {
+			t.Errorf("Expected suggestion %s, got %+v", key, suggestions)
+		}
+	}
+
+	for i := 1; i < len(suggestions); i++ {
+		prev, cur := suggestions[i-1], suggestions[i]
+		if cur.Simulated && !prev.Simulated || cur.Simulated && cur.ScoreImpact > prev.ScoreImpact {
+			t.Errorf("Expected simulated suggestions first, ordered by score impact, got %+v", suggestions)
+			break
+		}
+	}
+}
+
+func TestEngine_SuggestImprovements_OnlyDictionarySkills(t *testing.T) {
+	cv := "# Jane Doe\n\n## Skills\nGo, Docker\n"
+	jd := "# Platform Engineer\n\nWe are looking for a passionate, driven engineer to join our friendly team. You will work with Go and Kubernetes. Java is not required."
+
+	suggestions, _, err := NewAnalysisEngine().SuggestImprovements(context.Background(), cv, jd)
+	if err != nil {
+		t.Fatalf("SuggestImprovements failed: %v", err)
+	}
+
+	dict := NewSkillsDictionary()
+	var targets []string
+	for _, s := range suggestions {
+		if s.Kind != SuggestMissingRequirement {
+			continue
+		}
+		if _, ok := dict.FindSkill(s.Target); !ok {
+			t.Errorf("Expected only dictionary skills as missing requirements, got %q", s.Target)
+		}
+		targets = append(targets, s.Target)
+	}
+	if len(targets) != 1 || targets[0] != "kubernetes" {
+		t.Errorf("Expected kubernetes as the only missing requirement, got %v", targets)
+	}
+}
+
+func TestEngine_SuggestImprovements_StrictSkills(t *testing.T) {
+	cv := "# Jane Doe\n\n## Experience\n### Firmware Engineer - Acme\n2020 - Present\n- Wrote embedded firmware in Rust and Python\n"
+	jd := "# Firmware Engineer\n\n## Requirements\n- C for embedded firmware\n- Python\n"
+
+	suggestions, _, err := NewAnalysisEngine().SuggestImprovements(context.Background(), cv, jd)
+	if err != nil {
+		t.Fatalf("SuggestImprovements failed: %v", err)
+	}
+
+	for _, s := range suggestions {
+		if s.Kind != SuggestMissingRequirement || s.Target != "c" {
+			continue
+		}
+		if !strings.Contains(s.Issue, "asks for C,") || !strings.Contains(s.Hint, "with C,") {
+			t.Errorf("Expected the dictionary casing in the issue and hint, got %+v", s)
+		}
+		if s.ScoreImpact <= 0 {
+			t.Errorf("Expected mentioning C to raise the score, got %d", s.ScoreImpact)
+		}
+		return
+	}
+	t.Fatalf("Expected a missing requirement suggestion for c, got %+v", suggestions)
+}
+
+func TestAppendToSection(t *testing.T) {
+	content := "# Jane\n\n## Skills\nGo\n\n## Education\nBSc\n"
+	cv := parse.ParseCV(content)
+
+	got := appendToSection(content, cv, parse.SectionSkills, "Skills", "Docker")
+	if want := "# Jane\n\n## Skills\nGo\nDocker\n\n## Education\nBSc\n"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	got = appendToSection(content, cv, parse.SectionSummary, "Summary", "Go developer")
+	if !strings.HasSuffix(got, "\n\n## Summary\nGo developer\n") {
+		t.Errorf("Expected a new summary section, got %q", got)
+	}
+}
@@ -0,0 +1,220 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Training settings for logistic regression on score components
+const (
+	// MinTrainingSamples is the fewest labeled outcomes weights are fitted from
+	MinTrainingSamples = 10
+
+	// validationEvery holds out every n-th sample for validation
+	validationEvery = 5
+
+	trainingIterations = 3000
+	learningRate       = 0.5
+	l2Penalty          = 0.01
+)
+
+// TrainingSample is the score breakdown of a CV/JD pair and whether the candidate was hired
+type TrainingSample struct {
+	Components ScoreBreakdown `json:"components"`
+	Hired      bool           `json:"hired"`
+}
+
+// TrainingMetrics measure how well scores separate hired from rejected candidates
+type TrainingMetrics struct {
+	Samples  int     `json:"samples"`
+	Hired    int     `json:"hired"`
+	Accuracy float64 `json:"accuracy"` // Fitted model at a 0.5 threshold
+	LogLoss  float64 `json:"log_loss"` // Fitted model
+	// AUC is the chance a hired candidate outscores a rejected one with the learned weights,
+	// BaselineAUC the same with the default weights (both 0 when a class is missing)
+	AUC         float64 `json:"auc,omitempty"`
+	BaselineAUC float64 `json:"baseline_auc,omitempty"`
+}
+
+// TrainingResult holds weights fitted from outcomes and how they perform
+type TrainingResult struct {
+	Weights ScoringWeights `json:"weights"`
+	// Coefficients and Intercept are the raw logistic regression model over the components,
+	// in ScoringWeights order; negative coefficients get zero weight
+	Coefficients [5]float64      `json:"coefficients"`
+	Intercept    float64         `json:"intercept"`
+	Training     TrainingMetrics `json:"training"`
+	Validation   TrainingMetrics `json:"validation"`
+}
+
+// features returns a breakdown's components in ScoringWeights order
+func features(b ScoreBreakdown) [5]float64 {
+	return [5]float64{b.SkillCoverage, b.Experience, b.TermSimilarity, b.OverallMatch, b.Proficiency}
+}
+
+// TrainWeights fits ScoringWeights to labeled outcomes with L2-regularized logistic regression on
+// the score components. Every fifth sample is held out for validation. The weights are the
+// positive coefficients normalized to sum to 1, so components that don't predict hiring drop out.
+func TrainWeights(samples []TrainingSample) (*TrainingResult, error) {
+	if len(samples) < MinTrainingSamples {
+		return nil, fmt.Errorf("need at least %d outcomes to train, got %d", MinTrainingSamples, len(samples))
+	}
+
+	var train, validation []TrainingSample
+	for i, sample := range samples {
+		if i%validationEvery == validationEvery-1 {
+			validation = append(validation, sample)
+		} else {
+			train = append(train, sample)
+		}
+	}
+	hired := countHired(train)
+	if hired == 0 || hired == len(train) {
+		return nil, fmt.Errorf("training outcomes must include both hired and rejected candidates")
+	}
+
+	var coef [5]float64
+	intercept := 0.0
+	n := float64(len(train))
+	for iter := 0; iter < trainingIterations; iter++ {
+		var grad [5]float64
+		gradIntercept := 0.0
+		for _, sample := range train {
+			x := features(sample.Components)
+			residual := predict(coef, intercept, x) - label(sample)
+			for j := range grad {
+				grad[j] += residual * x[j]
+			}
+			gradIntercept += residual
+		}
+		for j := range coef {
+			coef[j] -= learningRate * (grad[j]/n + l2Penalty*coef[j])
+		}
+		intercept -= learningRate * gradIntercept / n
+	}
+
+	weights := ScoringWeights{
+		SkillCoverage:  math.Max(coef[0], 0),
+		Experience:     math.Max(coef[1], 0),
+		TermSimilarity: math.Max(coef[2], 0),
+		OverallMatch:   math.Max(coef[3], 0),
+		Proficiency:    math.Max(coef[4], 0),
+	}
+	if weights.SkillCoverage+weights.Experience+weights.TermSimilarity+weights.OverallMatch+weights.Proficiency == 0 {
+		return nil, fmt.Errorf("no score component predicts hiring in these outcomes")
+	}
+	weights = weights.Normalize()
+
+	return &TrainingResult{
+		Weights:      weights,
+		Coefficients: coef,
+		Intercept:    intercept,
+		Training:     evaluateModel(train, coef, intercept, weights),
+		Validation:   evaluateModel(validation, coef, intercept, weights),
+	}, nil
+}
+
+// predict returns the modeled probability of a hire
+func predict(coef [5]float64, intercept float64, x [5]float64) float64 {
+	z := intercept
+	for j := range coef {
+		z += coef[j] * x[j]
+	}
+	return 1 / (1 + math.Exp(-z))
+}
+
+// label returns 1 for a hire and 0 otherwise
+func label(sample TrainingSample) float64 {
+	if sample.Hired {
+		return 1
+	}
+	return 0
+}
+
+// countHired counts the hired samples
+func countHired(samples []TrainingSample) int {
+	hired := 0
+	for _, sample := range samples {
+		if sample.Hired {
+			hired++
+		}
+	}
+	return hired
+}
+
+// evaluateModel computes metrics of the fitted model and of the learned and default weights
+func evaluateModel(samples []TrainingSample, coef [5]float64, intercept float64, weights ScoringWeights) TrainingMetrics {
+	metrics := TrainingMetrics{Samples: len(samples), Hired: countHired(samples)}
+	if len(samples) == 0 {
+		return metrics
+	}
+
+	correct := 0
+	logLoss := 0.0
+	for _, sample := range samples {
+		p := math.Min(math.Max(predict(coef, intercept, features(sample.Components)), 1e-9), 1-1e-9)
+		if (p >= 0.5) == sample.Hired {
+			correct++
+		}
+		if sample.Hired {
+			logLoss -= math.Log(p)
+		} else {
+			logLoss -= math.Log(1 - p)
+		}
+	}
+	metrics.Accuracy = float64(correct) / float64(len(samples))
+	metrics.LogLoss = logLoss / float64(len(samples))
+	metrics.AUC = weightedAUC(samples, weights)
+	metrics.BaselineAUC = weightedAUC(samples, NewDefaultWeights())
+	return metrics
+}
+
+// weightedAUC returns the probability that a hired sample outscores a rejected one under the
+// weights (ties count half), or 0 when either class is missing
+func weightedAUC(samples []TrainingSample, weights ScoringWeights) float64 {
+	type scored struct {
+		score float64
+		hired bool
+	}
+	w := features(ScoreBreakdown{
+		SkillCoverage:  weights.SkillCoverage,
+		Experience:     weights.Experience,
+		TermSimilarity: weights.TermSimilarity,
+		OverallMatch:   weights.OverallMatch,
+		Proficiency:    weights.Proficiency,
+	})
+	all := make([]scored, len(samples))
+	for i, sample := range samples {
+		x := features(sample.Components)
+		for j := range w {
+			all[i].score += w[j] * x[j]
+		}
+		all[i].hired = sample.Hired
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].score < all[j].score })
+
+	hired := countHired(samples)
+	rejected := len(samples) - hired
+	if hired == 0 || rejected == 0 {
+		return 0
+	}
+
+	// Mann-Whitney U over average ranks
+	rankSum := 0.0
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].score == all[i].score {
+			j++
+		}
+		rank := float64(i+j+1) / 2 // Average 1-based rank of the tie group
+		for k := i; k < j; k++ {
+			if all[k].hired {
+				rankSum += rank
+			}
+		}
+		i = j
+	}
+	u := rankSum - float64(hired*(hired+1))/2
+	return u / float64(hired*rejected)
+}
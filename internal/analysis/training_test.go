@@ -0,0 +1,84 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+// trainingSamples returns outcomes where experience decides hiring, skill coverage runs against
+// it and term similarity is noise
+func trainingSamples(n int) []TrainingSample {
+	samples := make([]TrainingSample, n)
+	for i := range samples {
+		experience := float64(i%10) / 10
+		samples[i] = TrainingSample{
+			Components: ScoreBreakdown{
+				SkillCoverage:  1 - experience,
+				Experience:     experience,
+				TermSimilarity: float64((i*7)%10) / 10,
+				OverallMatch:   0.4,
+				Proficiency:    0.5,
+			},
+			Hired: experience >= 0.5,
+		}
+	}
+	return samples
+}
+
+func TestTrainWeights(t *testing.T) {
+	result, err := TrainWeights(trainingSamples(60))
+	if err != nil {
+		t.Fatalf("TrainWeights failed: %v", err)
+	}
+
+	if err := result.Weights.ValidateWeights(); err != nil {
+		t.Errorf("Expected normalized weights: %v", err)
+	}
+	if result.Weights.Experience < 0.5 {
+		t.Errorf("Expected experience to dominate the learned weights, got %+v", result.Weights)
+	}
+	if result.Training.Samples != 48 || result.Validation.Samples != 12 {
+		t.Errorf("Expected a 48/12 split, got %d/%d", result.Training.Samples, result.Validation.Samples)
+	}
+	if result.Validation.AUC < 0.9 || result.Validation.AUC <= result.Validation.BaselineAUC {
+		t.Errorf("Expected learned weights to beat the defaults, got AUC %v vs %v", result.Validation.AUC, result.Validation.BaselineAUC)
+	}
+	if result.Weights.SkillCoverage != 0 || result.Coefficients[0] >= 0 {
+		t.Errorf("Expected the anti-correlated skill coverage to get no weight, got %+v", result)
+	}
+	if result.Training.Accuracy < 0.9 {
+		t.Errorf("Expected accurate training predictions, got %v", result.Training.Accuracy)
+	}
+}
+
+func TestTrainWeights_Errors(t *testing.T) {
+	if _, err := TrainWeights(trainingSamples(5)); err == nil || !strings.Contains(err.Error(), "at least") {
+		t.Errorf("Expected a too-few-samples error, got %v", err)
+	}
+
+	allHired := trainingSamples(20)
+	for i := range allHired {
+		allHired[i].Hired = true
+	}
+	if _, err := TrainWeights(allHired); err == nil {
+		t.Error("Expected an error without rejected candidates")
+	}
+}
+
+func TestWeightedAUC(t *testing.T) {
+	samples := []TrainingSample{
+		{Components: ScoreBreakdown{SkillCoverage: 0.9}, Hired: true},
+		{Components: ScoreBreakdown{SkillCoverage: 0.5}, Hired: true},
+		{Components: ScoreBreakdown{SkillCoverage: 0.5}, Hired: false},
+		{Components: ScoreBreakdown{SkillCoverage: 0.1}, Hired: false},
+	}
+	weights := ScoringWeights{SkillCoverage: 1}
+
+	// 4 hired/rejected pairs: 3 ordered correctly, 1 tie
+	if got := weightedAUC(samples, weights); !almostEqual(got, 0.875, 0.001) {
+		t.Errorf("Expected AUC 0.875, got %v", got)
+	}
+	if got := weightedAUC(samples[:2], weights); got != 0 {
+		t.Errorf("Expected 0 without rejected samples, got %v", got)
+	}
+}
@@ -0,0 +1,183 @@
+package analysis
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version compatibility between a CV skill and a JD requirement
+const (
+	VersionExact  = "exact"  // Same major version, or satisfies "2+"
+	VersionNewer  = "newer"  // Newer release of the same generation (Java 21 for Java 17)
+	VersionLegacy = "legacy" // Only older or different-generation experience (Java 8 for 17, AngularJS for Angular 2+)
+)
+
+// Coverage weights for each version compatibility level
+const (
+	newerVersionWeight  = 0.9
+	legacyVersionWeight = 0.5
+)
+
+// versionPattern matches a version right after a skill: "17", "3.11", "v3", "2+", "3.x", ".js 3"
+var versionPattern = regexp.MustCompile(`^(?:\.js)?\s*v?(\d{1,2}(?:\.\d+){0,2})(\+|\.x)?`)
+
+// skillGenerations is the first major version of a skill's current, incompatible generation
+var skillGenerations = map[string]int{
+	"python":  3,
+	"angular": 2,
+	"vue":     3,
+}
+
+// generationAliases name a generation with its own token ("AngularJS", "python3")
+var generationAliases = map[string]struct{ Skill, Version string }{
+	"angularjs": {"angular", "1"},
+	"python2":   {"python", "2"},
+	"python3":   {"python", "3"},
+}
+
+// generationSuffixes name a generation with the word after the skill ("Angular.js")
+var generationSuffixes = map[string]map[string]string{
+	"angular": {"js": "1"},
+}
+
+// SkillVersionMatch reports how a CV skill version compares with the JD requirement
+type SkillVersionMatch struct {
+	Skill    string `json:"skill"`
+	Required string `json:"required"`
+	Claimed  string `json:"claimed,omitempty"`
+	Match    string `json:"match,omitempty"` // "" when the CV names no version
+}
+
+// versionAfter returns the version written right after a skill mention, such as "Java 17" or
+// "Angular 2+". tail is the sentence text following the skill. Numbers that start an experience
+// phrase ("Python 3 years") are not versions.
+func versionAfter(skill, tail, nextWord string) string {
+	if version, ok := generationSuffixes[skill][nextWord]; ok {
+		return version
+	}
+
+	m := versionPattern.FindStringSubmatchIndex(tail)
+	if m == nil {
+		return ""
+	}
+	number := tail[m[2]:m[3]]
+	if phrases := findExperience(strings.ToLower(tail[m[2]:])); len(phrases) > 0 && phrases[0].Start == 0 {
+		return ""
+	}
+
+	version := normalizeVersion(skill, number)
+	if m[4] >= 0 && tail[m[4]:m[5]] == "+" {
+		version += "+"
+	}
+	return version
+}
+
+// normalizeVersion rewrites legacy numbering ("Java 1.8" is Java 8)
+func normalizeVersion(skill, version string) string {
+	parts := strings.Split(version, ".")
+	if skill == "java" && len(parts) > 1 && parts[0] == "1" {
+		return strings.Join(parts[1:], ".")
+	}
+	return version
+}
+
+// parseVersion splits "3.11+" into its numeric parts and the "or newer" flag
+func parseVersion(version string) (parts []int, orNewer bool) {
+	orNewer = strings.HasSuffix(version, "+")
+	for _, p := range strings.Split(strings.TrimSuffix(version, "+"), ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts, orNewer
+}
+
+// compareVersionParts compares a and b over the parts both specify
+func compareVersionParts(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// newerVersion reports whether version a is newer than b
+func newerVersion(a, b string) bool {
+	pa, _ := parseVersion(a)
+	pb, _ := parseVersion(b)
+	return compareVersionParts(pa, pb) > 0
+}
+
+// generation returns which incompatible generation a major version belongs to
+func generation(skill string, major int) int {
+	if boundary, ok := skillGenerations[skill]; ok && major >= boundary {
+		return 1
+	}
+	return 0
+}
+
+// CompareVersions classifies a claimed CV version against a required JD version.
+// It returns "" when either side has no version, since there is nothing to compare.
+func CompareVersions(skill, claimed, required string) string {
+	cv, _ := parseVersion(claimed)
+	jd, orNewer := parseVersion(required)
+	if len(cv) == 0 || len(jd) == 0 {
+		return ""
+	}
+
+	if generation(skill, cv[0]) != generation(skill, jd[0]) {
+		return VersionLegacy
+	}
+	switch cmp := compareVersionParts(cv, jd); {
+	case cmp == 0:
+		return VersionExact
+	case cmp > 0 && orNewer:
+		return VersionExact
+	case cmp > 0:
+		return VersionNewer
+	default:
+		return VersionLegacy
+	}
+}
+
+// versionWeight returns the coverage weight of a matched skill's version compatibility
+func versionWeight(match string) float64 {
+	switch match {
+	case VersionNewer:
+		return newerVersionWeight
+	case VersionLegacy:
+		return legacyVersionWeight
+	default:
+		return 1.0
+	}
+}
+
+// versionMatches lists the version compatibility of every matched skill the JD pins to a version
+func versionMatches(cvSkills, jdSkills []Skill) []SkillVersionMatch {
+	matches, _, _ := MatchSkills(cvSkills, jdSkills)
+	required := make(map[string]string, len(jdSkills))
+	for _, skill := range jdSkills {
+		required[skill.Name] = skill.Version
+	}
+
+	var result []SkillVersionMatch
+	for _, skill := range matches {
+		if required[skill.Name] == "" {
+			continue
+		}
+		result = append(result, SkillVersionMatch{
+			Skill:    skill.Name,
+			Required: required[skill.Name],
+			Claimed:  skill.Version,
+			Match:    skill.VersionMatch,
+		})
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExtractSkills_Versions(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSkillsDictionary()
+
+	tests := []struct {
+		content string
+		skill   string
+		version string
+	}{
+		{"Backend services in Java 17", "java", "17"},
+		{"Maintained Java 1.8 services", "java", "8"},
+		{"Java 8 and Java 17 migrations", "java", "17"},
+		{"Python 3.11 data pipelines", "python", "3.11"},
+		{"Legacy Python 2 scripts", "python", "2"},
+		{"Scripts in python3", "python", "3"},
+		{"Experience with Angular 2+", "angular", "2+"},
+		{"Maintained an Angular.js dashboard", "angular", "1"},
+		{"Maintained an AngularJS dashboard", "angular", "1"},
+		{"Frontend in Vue 3", "vue", "3"},
+		{"Python 3 years", "python", ""},
+		{"Python 3+ years of experience", "python", ""},
+		{"Java, Python", "java", ""},
+	}
+
+	for _, tt := range tests {
+		var found *Skill
+		for _, skill := range ExtractSkills(ctx, tt.content, sd) {
+			if skill.Name == tt.skill {
+				found = &skill
+				break
+			}
+		}
+		if found == nil {
+			t.Errorf("Skill %q not found in %q", tt.skill, tt.content)
+			continue
+		}
+		if found.Version != tt.version {
+			t.Errorf("For %q, expected %s version %q, got %q", tt.content, tt.skill, tt.version, found.Version)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		skill    string
+		claimed  string
+		required string
+		expected string
+	}{
+		{"java", "17", "17", VersionExact},
+		{"java", "21", "17", VersionNewer},
+		{"java", "8", "17", VersionLegacy},
+		{"python", "3.11", "3", VersionExact},
+		{"python", "2", "3", VersionLegacy},
+		{"angular", "1", "2+", VersionLegacy},
+		{"angular", "15", "2+", VersionExact},
+		{"vue", "2", "3", VersionLegacy},
+		{"vue", "3", "2", VersionLegacy}, // Different generation
+		{"java", "", "17", ""},
+		{"java", "17", "", ""},
+	}
+
+	for _, tt := range tests {
+		if result := CompareVersions(tt.skill, tt.claimed, tt.required); result != tt.expected {
+			t.Errorf("CompareVersions(%q, %q, %q) = %q, want %q", tt.skill, tt.claimed, tt.required, result, tt.expected)
+		}
+	}
+}
+
+func TestCalculateSkillCoverage_Versions(t *testing.T) {
+	jdSkills := []Skill{{Name: "java", Version: "17"}, {Name: "python"}}
+
+	current := CalculateSkillCoverage([]Skill{{Name: "java", Version: "17"}, {Name: "python"}}, jdSkills)
+	legacy := CalculateSkillCoverage([]Skill{{Name: "java", Version: "8"}, {Name: "python"}}, jdSkills)
+	unspecified := CalculateSkillCoverage([]Skill{{Name: "java"}, {Name: "python"}}, jdSkills)
+
+	if current != 1.0 || unspecified != 1.0 {
+		t.Errorf("Expected full coverage for matching or unspecified versions, got %f and %f", current, unspecified)
+	}
+	if legacy != 0.75 {
+		t.Errorf("Expected legacy-only Java to halve its weight (0.75), got %f", legacy)
+	}
+}
@@ -21,6 +21,46 @@ type DocumentConverter interface {
 	IsAvailable() bool
 }
 
+// MultiConverter delegates to the first available converter that supports the input
+type MultiConverter struct {
+	converters []DocumentConverter
+}
+
+// NewMultiConverter creates a converter that tries each converter in order
+func NewMultiConverter(converters ...DocumentConverter) *MultiConverter {
+	return &MultiConverter{converters: converters}
+}
+
+// Convert converts the input with the first matching converter
+func (m *MultiConverter) Convert(ctx context.Context, input string) (string, error) {
+	for _, c := range m.converters {
+		if c.IsAvailable() && c.Supports(input) {
+			return c.Convert(ctx, input)
+		}
+	}
+	return "", &ConversionError{Hint: "no converter supports this input"}
+}
+
+// Supports checks if any available converter supports the input
+func (m *MultiConverter) Supports(input string) bool {
+	for _, c := range m.converters {
+		if c.IsAvailable() && c.Supports(input) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAvailable returns true if at least one converter is available
+func (m *MultiConverter) IsAvailable() bool {
+	for _, c := range m.converters {
+		if c.IsAvailable() {
+			return true
+		}
+	}
+	return false
+}
+
 // InputType represents the type of input
 type InputType string
 
@@ -32,10 +72,11 @@ const (
 
 // InputInfo contains parsed input information
 type InputInfo struct {
-	Type InputType
-	Path string
-	URL  *url.URL
-	Ext  string
+	Type   InputType
+	Path   string
+	URL    *url.URL
+	Ext    string
+	Format DocumentFormat // Set for JSON Resume and Europass files or text
 }
 
 // ParseInput parses an input string and returns its type and info
@@ -57,11 +98,15 @@ func ParseInput(input string) InputInfo {
 		info.Type = InputTypeFile
 		info.Path = input
 		info.Ext = strings.ToLower(filepath.Ext(input))
+		if isResumeExtension(info.Ext) {
+			info.Format = sniffFileFormat(input)
+		}
 		return info
 	}
 
 	// Default to text
 	info.Type = InputTypeText
+	info.Format = DetectFormat([]byte(input))
 	return info
 }
 
@@ -79,6 +124,8 @@ func IsSupportedExtension(ext string) bool {
 		".md":   true,
 		".html": true,
 		".htm":  true,
+		".json": true,
+		".xml":  true,
 	}
 	return supported[strings.ToLower(ext)]
 }
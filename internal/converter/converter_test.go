@@ -56,6 +56,8 @@ func TestIsSupportedExtension(t *testing.T) {
 		{".md", true},
 		{".txt", true},
 		{".html", true},
+		{".json", true},
+		{".xml", true},
 		{".xyz", false},
 		{".exe", false},
 	}
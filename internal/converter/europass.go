@@ -0,0 +1,260 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// europassDocument is the Europass CV XML (SkillsPassport) document structure
+type europassDocument struct {
+	XMLName     xml.Name `xml:"SkillsPassport"`
+	LearnerInfo struct {
+		Identification struct {
+			PersonName struct {
+				FirstName string `xml:"FirstName"`
+				Surname   string `xml:"Surname"`
+			} `xml:"PersonName"`
+			ContactInfo struct {
+				Address struct {
+					Contact struct {
+						AddressLine  string `xml:"AddressLine"`
+						Municipality string `xml:"Municipality"`
+						Country      struct {
+							Code  string `xml:"Code"`
+							Label string `xml:"Label"`
+						} `xml:"Country"`
+					} `xml:"Contact"`
+				} `xml:"Address"`
+				Email struct {
+					Contact string `xml:"Contact"`
+				} `xml:"Email"`
+				TelephoneList struct {
+					Telephone []struct {
+						Contact string `xml:"Contact"`
+					} `xml:"Telephone"`
+				} `xml:"TelephoneList"`
+				WebsiteList struct {
+					Website []struct {
+						Contact string `xml:"Contact"`
+					} `xml:"Website"`
+				} `xml:"WebsiteList"`
+			} `xml:"ContactInfo"`
+		} `xml:"Identification"`
+		Headline struct {
+			Description struct {
+				Label string `xml:"Label"`
+			} `xml:"Description"`
+		} `xml:"Headline"`
+		WorkExperienceList struct {
+			WorkExperience []struct {
+				Period   europassPeriod `xml:"Period"`
+				Position struct {
+					Label string `xml:"Label"`
+				} `xml:"Position"`
+				Activities string `xml:"Activities"`
+				Employer   struct {
+					Name string `xml:"Name"`
+				} `xml:"Employer"`
+			} `xml:"WorkExperience"`
+		} `xml:"WorkExperienceList"`
+		EducationList struct {
+			Education []struct {
+				Period       europassPeriod `xml:"Period"`
+				Title        string         `xml:"Title"`
+				Activities   string         `xml:"Activities"`
+				Organisation struct {
+					Name string `xml:"Name"`
+				} `xml:"Organisation"`
+			} `xml:"Education"`
+		} `xml:"EducationList"`
+		Skills struct {
+			Linguistic struct {
+				MotherTongueList struct {
+					MotherTongue []struct {
+						Description struct {
+							Label string `xml:"Label"`
+						} `xml:"Description"`
+					} `xml:"MotherTongue"`
+				} `xml:"MotherTongueList"`
+				ForeignLanguageList struct {
+					ForeignLanguage []struct {
+						Description struct {
+							Label string `xml:"Label"`
+						} `xml:"Description"`
+						ProficiencyLevel struct {
+							Listening         string `xml:"Listening"`
+							Reading           string `xml:"Reading"`
+							SpokenInteraction string `xml:"SpokenInteraction"`
+							SpokenProduction  string `xml:"SpokenProduction"`
+							Writing           string `xml:"Writing"`
+						} `xml:"ProficiencyLevel"`
+					} `xml:"ForeignLanguage"`
+				} `xml:"ForeignLanguageList"`
+			} `xml:"Linguistic"`
+			Communication  europassDescription `xml:"Communication"`
+			Organisational europassDescription `xml:"Organisational"`
+			JobRelated     europassDescription `xml:"JobRelated"`
+			Computer       europassDescription `xml:"Computer"`
+			Other          europassDescription `xml:"Other"`
+		} `xml:"Skills"`
+	} `xml:"LearnerInfo"`
+}
+
+// europassPeriod is a Europass date range; months are written as "--03"
+type europassPeriod struct {
+	From    europassDate `xml:"From"`
+	To      europassDate `xml:"To"`
+	Current bool         `xml:"Current"`
+}
+
+// europassDate is a Europass partial date
+type europassDate struct {
+	Year  string `xml:"year,attr"`
+	Month string `xml:"month,attr"`
+}
+
+// europassDescription is a free-text skills block
+type europassDescription struct {
+	Description string `xml:"Description"`
+}
+
+// ParseEuropass converts Europass CV XML into the JSON Resume model
+func ParseEuropass(data []byte) (*JSONResume, error) {
+	var doc europassDocument
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse Europass XML: %w", err)
+	}
+
+	info := doc.LearnerInfo
+	id := info.Identification
+	resume := &JSONResume{
+		Basics: JSONResumeBasics{
+			Name:  joinParts(" ", id.PersonName.FirstName, id.PersonName.Surname),
+			Label: strings.TrimSpace(info.Headline.Description.Label),
+			Email: strings.TrimSpace(id.ContactInfo.Email.Contact),
+			Location: JSONResumeLocation{
+				Address:     strings.TrimSpace(id.ContactInfo.Address.Contact.AddressLine),
+				City:        strings.TrimSpace(id.ContactInfo.Address.Contact.Municipality),
+				Region:      strings.TrimSpace(id.ContactInfo.Address.Contact.Country.Label),
+				CountryCode: strings.TrimSpace(id.ContactInfo.Address.Contact.Country.Code),
+			},
+		},
+	}
+	// The country label is more readable than its code
+	if resume.Basics.Location.Region != "" {
+		resume.Basics.Location.CountryCode = ""
+	}
+	if phones := id.ContactInfo.TelephoneList.Telephone; len(phones) > 0 {
+		resume.Basics.Phone = strings.TrimSpace(phones[0].Contact)
+	}
+	for _, site := range id.ContactInfo.WebsiteList.Website {
+		if link := strings.TrimSpace(site.Contact); link != "" {
+			resume.Basics.Profiles = append(resume.Basics.Profiles, JSONResumeProfile{Network: linkNetwork(link), URL: link})
+		}
+	}
+
+	for _, work := range info.WorkExperienceList.WorkExperience {
+		entry := JSONResumeWork{
+			Name:       strings.TrimSpace(work.Employer.Name),
+			Position:   strings.TrimSpace(work.Position.Label),
+			StartDate:  work.Period.From.iso(),
+			Highlights: europassLines(work.Activities),
+		}
+		if !work.Period.Current {
+			entry.EndDate = work.Period.To.iso()
+		}
+		resume.Work = append(resume.Work, entry)
+	}
+
+	for _, edu := range info.EducationList.Education {
+		resume.Education = append(resume.Education, JSONResumeEducation{
+			Institution: strings.TrimSpace(edu.Organisation.Name),
+			StudyType:   strings.TrimSpace(edu.Title),
+			StartDate:   edu.Period.From.iso(),
+			EndDate:     edu.Period.To.iso(),
+			Courses:     europassLines(edu.Activities),
+		})
+	}
+
+	skills := info.Skills
+	for _, block := range []struct {
+		name string
+		text string
+	}{
+		{"Computer", skills.Computer.Description},
+		{"Job-related", skills.JobRelated.Description},
+		{"Communication", skills.Communication.Description},
+		{"Organisational", skills.Organisational.Description},
+		{"Other", skills.Other.Description},
+	} {
+		if keywords := europassKeywords(block.text); len(keywords) > 0 {
+			resume.Skills = append(resume.Skills, JSONResumeSkill{Name: block.name, Keywords: keywords})
+		}
+	}
+
+	for _, tongue := range skills.Linguistic.MotherTongueList.MotherTongue {
+		resume.Languages = append(resume.Languages, JSONResumeLanguage{Language: strings.TrimSpace(tongue.Description.Label), Fluency: "Native"})
+	}
+	for _, foreign := range skills.Linguistic.ForeignLanguageList.ForeignLanguage {
+		level := foreign.ProficiencyLevel
+		resume.Languages = append(resume.Languages, JSONResumeLanguage{
+			Language: strings.TrimSpace(foreign.Description.Label),
+			Fluency:  highestCEFRLevel(level.Listening, level.Reading, level.SpokenInteraction, level.SpokenProduction, level.Writing),
+		})
+	}
+
+	return resume, nil
+}
+
+// iso formats a Europass date as "2019-03" or "2019"
+func (d europassDate) iso() string {
+	year := strings.TrimSpace(d.Year)
+	month := strings.TrimLeft(strings.TrimSpace(d.Month), "-")
+	if year == "" {
+		return ""
+	}
+	if month == "" {
+		return year
+	}
+	return year + "-" + month
+}
+
+// europassLines splits free text (often HTML from the Europass editor) into lines
+func europassLines(text string) []string {
+	text = html.UnescapeString(htmlTagPattern.ReplaceAllString(text, "\n"))
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-•*")); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// europassKeywords splits a free-text skills block into individual keywords
+func europassKeywords(text string) []string {
+	var keywords []string
+	for _, line := range europassLines(text) {
+		for _, item := range strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ';' }) {
+			if item = strings.TrimSpace(item); item != "" {
+				keywords = append(keywords, item)
+			}
+		}
+	}
+	return keywords
+}
+
+// highestCEFRLevel returns the highest CEFR level (A1-C2) among the skill ratings
+func highestCEFRLevel(levels ...string) string {
+	best := ""
+	for _, level := range levels {
+		level = strings.ToUpper(strings.TrimSpace(level))
+		if level > best {
+			best = level
+		}
+	}
+	return best
+}
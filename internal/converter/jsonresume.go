@@ -0,0 +1,381 @@
+package converter
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+)
+
+// JSONResumeSchema is the schema URL written into exported documents
+const JSONResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// JSONResume is the subset of the JSON Resume schema (https://jsonresume.org/schema) used for import and export
+type JSONResume struct {
+	Schema       string                  `json:"$schema,omitempty"`
+	Basics       JSONResumeBasics        `json:"basics"`
+	Work         []JSONResumeWork        `json:"work,omitempty"`
+	Education    []JSONResumeEducation   `json:"education,omitempty"`
+	Skills       []JSONResumeSkill       `json:"skills,omitempty"`
+	Languages    []JSONResumeLanguage    `json:"languages,omitempty"`
+	Projects     []JSONResumeProject     `json:"projects,omitempty"`
+	Certificates []JSONResumeCertificate `json:"certificates,omitempty"`
+}
+
+// JSONResumeBasics holds the candidate's personal details
+type JSONResumeBasics struct {
+	Name     string              `json:"name,omitempty"`
+	Label    string              `json:"label,omitempty"`
+	Email    string              `json:"email,omitempty"`
+	Phone    string              `json:"phone,omitempty"`
+	URL      string              `json:"url,omitempty"`
+	Summary  string              `json:"summary,omitempty"`
+	Location JSONResumeLocation  `json:"location,omitempty"`
+	Profiles []JSONResumeProfile `json:"profiles,omitempty"`
+}
+
+// JSONResumeLocation is the candidate's address
+type JSONResumeLocation struct {
+	Address     string `json:"address,omitempty"`
+	City        string `json:"city,omitempty"`
+	Region      string `json:"region,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+}
+
+// JSONResumeProfile is a social or professional network profile
+type JSONResumeProfile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// JSONResumeWork is a position in the work history
+type JSONResumeWork struct {
+	Name       string   `json:"name,omitempty"`
+	Position   string   `json:"position,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// JSONResumeEducation is a degree or program
+type JSONResumeEducation struct {
+	Institution string   `json:"institution,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	StudyType   string   `json:"studyType,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Score       string   `json:"score,omitempty"`
+	Courses     []string `json:"courses,omitempty"`
+}
+
+// JSONResumeSkill is a skill group with optional keywords
+type JSONResumeSkill struct {
+	Name     string   `json:"name,omitempty"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// JSONResumeLanguage is a spoken language
+type JSONResumeLanguage struct {
+	Language string `json:"language,omitempty"`
+	Fluency  string `json:"fluency,omitempty"`
+}
+
+// JSONResumeProject is a personal or professional project
+type JSONResumeProject struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+}
+
+// JSONResumeCertificate is a certification
+type JSONResumeCertificate struct {
+	Name   string `json:"name,omitempty"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+var (
+	// isoDatePattern matches JSON Resume dates: "2019", "2019-03" or "2019-03-15"
+	isoDatePattern = regexp.MustCompile(`^((?:19|20)\d{2})(?:-(\d{1,2}))?(?:-\d{1,2})?$`)
+
+	// numericMonthPattern matches "03.2019" or "3/2019"
+	numericMonthPattern = regexp.MustCompile(`^(\d{1,2})[./]\s?(?:19|20)\d{2}$`)
+)
+
+// monthPrefixes maps English and Russian month name prefixes to month numbers
+var monthPrefixes = []struct {
+	prefix string
+	month  int
+}{
+	{"jan", 1}, {"feb", 2}, {"mar", 3}, {"apr", 4}, {"may", 5}, {"jun", 6},
+	{"jul", 7}, {"aug", 8}, {"sep", 9}, {"oct", 10}, {"nov", 11}, {"dec", 12},
+	{"янв", 1}, {"фев", 2}, {"мар", 3}, {"апр", 4}, {"ма", 5}, {"июн", 6},
+	{"июл", 7}, {"авг", 8}, {"сен", 9}, {"окт", 10}, {"ноя", 11}, {"дек", 12},
+}
+
+// ToMarkdown renders the resume as CV markdown that parse.ParseCV reads back
+func (r *JSONResume) ToMarkdown() string {
+	var sb strings.Builder
+
+	b := r.Basics
+	sb.WriteString("# " + joinParts(" - ", b.Name, b.Label) + "\n\n")
+
+	contact := []string{b.Email, b.Phone, b.URL}
+	for _, profile := range b.Profiles {
+		contact = append(contact, profile.URL)
+	}
+	if line := joinParts(" | ", contact...); line != "" {
+		sb.WriteString(line + "\n")
+	}
+	if location := joinParts(", ", b.Location.Address, b.Location.City, b.Location.Region, b.Location.CountryCode); location != "" {
+		sb.WriteString("Location: " + location + "\n")
+	}
+
+	if b.Summary != "" {
+		sb.WriteString("\n## Summary\n" + strings.TrimSpace(b.Summary) + "\n")
+	}
+
+	if len(r.Work) > 0 {
+		sb.WriteString("\n## Experience\n")
+		for _, work := range r.Work {
+			sb.WriteString(fmt.Sprintf("\n### %s%s\n", joinParts(" @ ", work.Position, work.Name), markdownPeriod(work.StartDate, work.EndDate)))
+			if work.Summary != "" {
+				sb.WriteString(strings.TrimSpace(work.Summary) + "\n")
+			}
+			for _, highlight := range work.Highlights {
+				sb.WriteString("- " + highlight + "\n")
+			}
+		}
+	}
+
+	if len(r.Education) > 0 {
+		sb.WriteString("\n## Education\n")
+		for _, edu := range r.Education {
+			degree := joinParts(" ", edu.StudyType, edu.Area)
+			line := joinParts(" - ", degree, edu.Institution)
+			if period := strings.Trim(markdownPeriod(edu.StartDate, edu.EndDate), " ()"); period != "" {
+				line += ", " + period
+			}
+			sb.WriteString("- " + line + "\n")
+			if edu.Score != "" {
+				sb.WriteString("  Score: " + edu.Score + "\n")
+			}
+			if len(edu.Courses) > 0 {
+				sb.WriteString("  Courses: " + strings.Join(edu.Courses, ", ") + "\n")
+			}
+		}
+	}
+
+	if len(r.Skills) > 0 {
+		sb.WriteString("\n## Skills\n")
+		for _, skill := range r.Skills {
+			if len(skill.Keywords) > 0 {
+				sb.WriteString("- " + skill.Name + ": " + strings.Join(skill.Keywords, ", ") + "\n")
+			} else {
+				sb.WriteString("- " + skill.Name + "\n")
+			}
+		}
+	}
+
+	if len(r.Languages) > 0 {
+		sb.WriteString("\n## Languages\n")
+		for _, lang := range r.Languages {
+			if lang.Fluency != "" {
+				sb.WriteString(fmt.Sprintf("- %s (%s)\n", lang.Language, lang.Fluency))
+			} else {
+				sb.WriteString("- " + lang.Language + "\n")
+			}
+		}
+	}
+
+	if len(r.Projects) > 0 {
+		sb.WriteString("\n## Projects\n")
+		for _, project := range r.Projects {
+			sb.WriteString("\n### " + project.Name + "\n")
+			if project.Description != "" {
+				sb.WriteString(strings.TrimSpace(project.Description) + "\n")
+			}
+			for _, highlight := range project.Highlights {
+				sb.WriteString("- " + highlight + "\n")
+			}
+			if len(project.Keywords) > 0 {
+				sb.WriteString("Tech: " + strings.Join(project.Keywords, ", ") + "\n")
+			}
+		}
+	}
+
+	if len(r.Certificates) > 0 {
+		sb.WriteString("\n## Certifications\n")
+		for _, cert := range r.Certificates {
+			line := joinParts(" - ", cert.Name, cert.Issuer)
+			if cert.Date != "" {
+				line += " (" + markdownDate(cert.Date) + ")"
+			}
+			sb.WriteString("- " + line + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// NewJSONResumeFromCV builds a JSON Resume document from a parsed CV
+func NewJSONResumeFromCV(cv *parse.CV) *JSONResume {
+	resume := &JSONResume{
+		Schema: JSONResumeSchema,
+		Basics: JSONResumeBasics{
+			Name:    cv.Contact.Name,
+			Label:   cv.Headline,
+			Email:   cv.Contact.Email,
+			Phone:   cv.Contact.Phone,
+			Summary: cv.Summary,
+		},
+	}
+
+	if city, region, ok := strings.Cut(cv.Contact.Location, ","); ok {
+		resume.Basics.Location = JSONResumeLocation{City: strings.TrimSpace(city), Region: strings.TrimSpace(region)}
+	} else {
+		resume.Basics.Location = JSONResumeLocation{City: cv.Contact.Location}
+	}
+	for _, link := range cv.Contact.Links {
+		resume.Basics.Profiles = append(resume.Basics.Profiles, JSONResumeProfile{Network: linkNetwork(link), URL: link})
+	}
+
+	for _, entry := range cv.Experience {
+		work := JSONResumeWork{
+			Name:       entry.Company,
+			Position:   entry.Title,
+			StartDate:  isoDate(entry.Dates.Start, entry.Dates.StartYear),
+			Highlights: entry.Bullets,
+		}
+		if !entry.Dates.Current {
+			work.EndDate = isoDate(entry.Dates.End, entry.Dates.EndYear)
+		}
+		resume.Work = append(resume.Work, work)
+	}
+
+	for _, entry := range cv.Education {
+		resume.Education = append(resume.Education, JSONResumeEducation{
+			Institution: entry.Institution,
+			StudyType:   entry.Degree,
+			StartDate:   isoDate(entry.Dates.Start, entry.Dates.StartYear),
+			EndDate:     isoDate(entry.Dates.End, entry.Dates.EndYear),
+		})
+	}
+
+	for _, skill := range cv.Skills {
+		resume.Skills = append(resume.Skills, JSONResumeSkill{Name: skill})
+	}
+
+	for _, lang := range cv.Languages {
+		resume.Languages = append(resume.Languages, JSONResumeLanguage{Language: lang.Name, Fluency: lang.Level})
+	}
+
+	for _, project := range cv.Projects {
+		resume.Projects = append(resume.Projects, JSONResumeProject{
+			Name:        project.Name,
+			Description: project.Description,
+			Highlights:  project.Bullets,
+			Keywords:    project.Technologies,
+		})
+	}
+
+	for _, cert := range cv.Certifications {
+		resume.Certificates = append(resume.Certificates, JSONResumeCertificate{Name: cert})
+	}
+
+	return resume
+}
+
+// markdownPeriod formats JSON Resume start/end dates as " (03.2019 - Present)"
+func markdownPeriod(start, end string) string {
+	if start == "" && end == "" {
+		return ""
+	}
+	if start == "" {
+		return " (" + markdownDate(end) + ")"
+	}
+	if end == "" {
+		return " (" + markdownDate(start) + " - Present)"
+	}
+	return " (" + markdownDate(start) + " - " + markdownDate(end) + ")"
+}
+
+// markdownDate converts "2019-03-15" to "03.2019", a format the CV parser recognizes
+func markdownDate(date string) string {
+	m := isoDatePattern.FindStringSubmatch(strings.TrimSpace(date))
+	if m == nil {
+		return date
+	}
+	if m[2] == "" {
+		return m[1]
+	}
+	month, _ := strconv.Atoi(m[2])
+	return fmt.Sprintf("%02d.%s", month, m[1])
+}
+
+// isoDate converts a CV date such as "Jan 2021" or "03.2019" to "2021-01"; year-only dates stay "2021"
+func isoDate(text string, year int) string {
+	if year == 0 {
+		return ""
+	}
+	text = strings.ToLower(strings.TrimSpace(text))
+
+	if m := numericMonthPattern.FindStringSubmatch(text); m != nil {
+		month, _ := strconv.Atoi(m[1])
+		return fmt.Sprintf("%d-%02d", year, month)
+	}
+	for _, mp := range monthPrefixes {
+		if strings.HasPrefix(text, mp.prefix) {
+			return fmt.Sprintf("%d-%02d", year, mp.month)
+		}
+	}
+	return fmt.Sprint(year)
+}
+
+// linkNetwork names the network of a profile link, e.g. "GitHub" for github.com URLs
+func linkNetwork(link string) string {
+	raw := link
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	switch {
+	case strings.HasSuffix(host, "github.com"):
+		return "GitHub"
+	case strings.HasSuffix(host, "linkedin.com"):
+		return "LinkedIn"
+	case strings.HasSuffix(host, "gitlab.com"):
+		return "GitLab"
+	case strings.HasSuffix(host, "stackoverflow.com"):
+		return "Stack Overflow"
+	default:
+		return host
+	}
+}
+
+// joinParts joins the non-empty parts with sep
+func joinParts(sep string, parts ...string) string {
+	var values []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return strings.Join(values, sep)
+}
@@ -0,0 +1,190 @@
+package converter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// DocumentFormat identifies a structured CV interchange format
+type DocumentFormat string
+
+const (
+	FormatUnknown    DocumentFormat = ""
+	FormatJSONResume DocumentFormat = "json_resume"
+	FormatEuropass   DocumentFormat = "europass"
+)
+
+// sniffLimit is how much of a file is read to detect its format
+const sniffLimit = 64 * 1024
+
+var (
+	// htmlTagPattern matches HTML tags embedded in Europass free-text fields
+	htmlTagPattern = regexp.MustCompile(`<[^>]+>`)
+
+	// europassRootPattern matches the Europass CV root element
+	europassRootPattern = regexp.MustCompile(`<(?:\w+:)?SkillsPassport[\s>]`)
+)
+
+// DetectFormat reports whether data is a JSON Resume or Europass XML document
+func DetectFormat(data []byte) DocumentFormat {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+	if len(trimmed) == 0 {
+		return FormatUnknown
+	}
+
+	switch trimmed[0] {
+	case '{':
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &keys); err != nil {
+			return FormatUnknown
+		}
+		if _, ok := keys["basics"]; ok {
+			return FormatJSONResume
+		}
+	case '<':
+		if europassRootPattern.Match(trimmed) {
+			return FormatEuropass
+		}
+	}
+	return FormatUnknown
+}
+
+// isResumeExtension reports whether ext may hold a JSON Resume or Europass document
+func isResumeExtension(ext string) bool {
+	return strings.EqualFold(ext, ".json") || strings.EqualFold(ext, ".xml")
+}
+
+// sniffFileFormat reads the head of a .json or .xml file and detects its format
+func sniffFileFormat(path string) DocumentFormat {
+	// #nosec G304 - only called for paths that os.Stat already resolved
+	f, err := os.Open(path)
+	if err != nil {
+		return FormatUnknown
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			slog.Debug("error closing file", "error", closeErr)
+		}
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(f, sniffLimit))
+	if err != nil {
+		return FormatUnknown
+	}
+	if len(data) == sniffLimit {
+		// Too large to decode partially; fall back to the extension
+		if strings.EqualFold(filepath.Ext(path), ".json") {
+			return FormatJSONResume
+		}
+		return FormatEuropass
+	}
+	return DetectFormat(data)
+}
+
+// ConvertResume converts a JSON Resume or Europass XML document to CV markdown
+func ConvertResume(data []byte) (string, error) {
+	var resume *JSONResume
+
+	switch DetectFormat(data) {
+	case FormatJSONResume:
+		resume = &JSONResume{}
+		if err := json.Unmarshal(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")), resume); err != nil {
+			return "", fmt.Errorf("failed to parse JSON Resume: %w", err)
+		}
+	case FormatEuropass:
+		var err error
+		resume, err = ParseEuropass(data)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("document is neither JSON Resume nor Europass XML")
+	}
+
+	return resume.ToMarkdown(), nil
+}
+
+// ResumeConverter converts JSON Resume and Europass XML CVs to markdown
+type ResumeConverter struct{}
+
+// NewResumeConverter creates a new ResumeConverter
+func NewResumeConverter() *ResumeConverter {
+	return &ResumeConverter{}
+}
+
+// IsAvailable always returns true; the converter has no external dependencies
+func (c *ResumeConverter) IsAvailable() bool {
+	return true
+}
+
+// Supports checks if the input is a JSON Resume or Europass document
+func (c *ResumeConverter) Supports(input string) bool {
+	info := ParseInput(input)
+	if info.Type == InputTypeURL {
+		return isResumeExtension(info.Ext)
+	}
+	return info.Format != FormatUnknown
+}
+
+// Convert converts a JSON Resume or Europass document from a file, URL or raw text
+func (c *ResumeConverter) Convert(ctx context.Context, input string) (string, error) {
+	info := ParseInput(input)
+
+	switch info.Type {
+	case InputTypeFile:
+		return c.convertFile(info.Path)
+	case InputTypeURL:
+		return c.convertURL(ctx, info.URL.String())
+	default:
+		markdown, err := ConvertResume([]byte(input))
+		if err != nil {
+			return "", &ConversionError{OriginalError: err, Hint: "failed to convert resume text"}
+		}
+		return markdown, nil
+	}
+}
+
+// convertFile converts a local JSON Resume or Europass file
+func (c *ResumeConverter) convertFile(path string) (string, error) {
+	if strings.Contains(path, "..") {
+		return "", &PathValidationError{Path: path, Reason: "path traversal not allowed"}
+	}
+	if strings.Contains(path, "\x00") {
+		return "", &PathValidationError{Path: path, Reason: "null bytes not allowed"}
+	}
+
+	// #nosec G304 - path has been validated for traversal and null bytes
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", &FileNotFoundError{Path: path}
+	}
+
+	markdown, err := ConvertResume(data)
+	if err != nil {
+		return "", &ConversionError{OriginalError: err, Path: path, Hint: "failed to convert resume file"}
+	}
+	return markdown, nil
+}
+
+// convertURL downloads and converts a remote JSON Resume or Europass file
+func (c *ResumeConverter) convertURL(ctx context.Context, url string) (string, error) {
+	tmpFile, err := DownloadFile(ctx, url, os.TempDir())
+	if err != nil {
+		return "", &ConversionError{OriginalError: err, Path: url, Hint: "failed to download resume"}
+	}
+	defer func() {
+		if removeErr := os.Remove(tmpFile); removeErr != nil {
+			slog.Debug("error removing temp file", "error", removeErr)
+		}
+	}()
+
+	return c.convertFile(tmpFile)
+}
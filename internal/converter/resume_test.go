@@ -0,0 +1,210 @@
+package converter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleJSONResume = `{
+  "basics": {
+    "name": "Jane Doe",
+    "label": "Backend Engineer",
+    "email": "jane@example.com",
+    "phone": "+49 151 1234 5678",
+    "summary": "Backend engineer focused on distributed systems.",
+    "location": {"city": "Berlin", "countryCode": "DE"},
+    "profiles": [{"network": "GitHub", "url": "https://github.com/janedoe"}]
+  },
+  "work": [{
+    "name": "Acme Corp",
+    "position": "Senior Engineer",
+    "startDate": "2021-01-15",
+    "highlights": ["Led migration to Kubernetes"]
+  }, {
+    "name": "Initech",
+    "position": "Engineer",
+    "startDate": "2016-03",
+    "endDate": "2020-12"
+  }],
+  "education": [{"institution": "State University", "area": "Computer Science", "studyType": "BSc", "startDate": "2012", "endDate": "2016"}],
+  "skills": [{"name": "Backend", "keywords": ["Go", "PostgreSQL"]}],
+  "languages": [{"language": "English", "fluency": "C1"}]
+}`
+
+const sampleEuropass = `<?xml version="1.0" encoding="UTF-8"?>
+<SkillsPassport xmlns="http://europass.cedefop.europa.eu/Europass" locale="en">
+  <LearnerInfo>
+    <Identification>
+      <PersonName><FirstName>Marco</FirstName><Surname>Rossi</Surname></PersonName>
+      <ContactInfo>
+        <Address><Contact><Municipality>Milan</Municipality><Country><Code>IT</Code><Label>Italy</Label></Country></Contact></Address>
+        <Email><Contact>marco@example.com</Contact></Email>
+        <TelephoneList><Telephone><Contact>+39 333 123 4567</Contact></Telephone></TelephoneList>
+      </ContactInfo>
+    </Identification>
+    <Headline><Description><Label>Data Engineer</Label></Description></Headline>
+    <WorkExperienceList>
+      <WorkExperience>
+        <Period><From year="2019" month="--03"/><Current>true</Current></Period>
+        <Position><Label>Data Engineer</Label></Position>
+        <Activities>&lt;p&gt;Built Spark pipelines&lt;/p&gt;&lt;p&gt;Maintained Airflow&lt;/p&gt;</Activities>
+        <Employer><Name>Globex</Name></Employer>
+      </WorkExperience>
+    </WorkExperienceList>
+    <EducationList>
+      <Education>
+        <Period><From year="2013"/><To year="2018"/></Period>
+        <Title>MSc Computer Engineering</Title>
+        <Organisation><Name>Politecnico di Milano</Name></Organisation>
+      </Education>
+    </EducationList>
+    <Skills>
+      <Linguistic>
+        <MotherTongueList><MotherTongue><Description><Label>Italian</Label></Description></MotherTongue></MotherTongueList>
+        <ForeignLanguageList><ForeignLanguage>
+          <Description><Label>English</Label></Description>
+          <ProficiencyLevel><Listening>C1</Listening><Reading>C2</Reading><Writing>B2</Writing></ProficiencyLevel>
+        </ForeignLanguage></ForeignLanguageList>
+      </Linguistic>
+      <Computer><Description>Python, Spark, SQL</Description></Computer>
+    </Skills>
+  </LearnerInfo>
+</SkillsPassport>`
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected DocumentFormat
+	}{
+		{"JSON Resume", sampleJSONResume, FormatJSONResume},
+		{"Europass", sampleEuropass, FormatEuropass},
+		{"other JSON", `{"name": "x"}`, FormatUnknown},
+		{"other XML", `<html><body/></html>`, FormatUnknown},
+		{"markdown", "# CV\n\nJane Doe", FormatUnknown},
+		{"empty", "", FormatUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DetectFormat([]byte(tt.data)))
+		})
+	}
+}
+
+func TestParseInput_ResumeFormats(t *testing.T) {
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "resume.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(sampleJSONResume), 0600))
+	xmlFile := filepath.Join(dir, "europass.xml")
+	require.NoError(t, os.WriteFile(xmlFile, []byte(sampleEuropass), 0600))
+	otherFile := filepath.Join(dir, "package.json")
+	require.NoError(t, os.WriteFile(otherFile, []byte(`{"name": "pkg"}`), 0600))
+
+	assert.Equal(t, FormatJSONResume, ParseInput(jsonFile).Format)
+	assert.Equal(t, FormatEuropass, ParseInput(xmlFile).Format)
+	assert.Equal(t, FormatUnknown, ParseInput(otherFile).Format)
+	assert.Equal(t, FormatJSONResume, ParseInput(sampleJSONResume).Format)
+
+	c := NewResumeConverter()
+	assert.True(t, c.Supports(jsonFile))
+	assert.True(t, c.Supports(xmlFile))
+	assert.False(t, c.Supports(otherFile))
+	assert.True(t, c.Supports("https://example.com/cv.json"))
+	assert.False(t, c.Supports("# Plain markdown CV"))
+}
+
+func TestResumeConverter_JSONResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resume.json")
+	require.NoError(t, os.WriteFile(path, []byte(sampleJSONResume), 0600))
+
+	markdown, err := NewResumeConverter().Convert(context.Background(), path)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "# Jane Doe - Backend Engineer")
+
+	// The markdown round-trips through the CV parser
+	cv := parse.ParseCV(markdown)
+	assert.Equal(t, "Jane Doe", cv.Contact.Name)
+	assert.Equal(t, "Backend Engineer", cv.Headline)
+	assert.Equal(t, "jane@example.com", cv.Contact.Email)
+	assert.Equal(t, "Berlin, DE", cv.Contact.Location)
+	require.Len(t, cv.Experience, 2)
+	assert.Equal(t, "Senior Engineer", cv.Experience[0].Title)
+	assert.Equal(t, "Acme Corp", cv.Experience[0].Company)
+	assert.Equal(t, 2021, cv.Experience[0].Dates.StartYear)
+	assert.True(t, cv.Experience[0].Dates.Current)
+	assert.Equal(t, 2020, cv.Experience[1].Dates.EndYear)
+	require.Len(t, cv.Education, 1)
+	assert.Equal(t, "BSc Computer Science", cv.Education[0].Degree)
+	assert.Equal(t, "State University", cv.Education[0].Institution)
+	assert.Equal(t, []string{"Go", "PostgreSQL"}, cv.Skills)
+	assert.Equal(t, []parse.Language{{Name: "English", Level: "C1"}}, cv.Languages)
+}
+
+func TestResumeConverter_Europass(t *testing.T) {
+	markdown, err := NewResumeConverter().Convert(context.Background(), sampleEuropass)
+	require.NoError(t, err)
+
+	cv := parse.ParseCV(markdown)
+	assert.Equal(t, "Marco Rossi", cv.Contact.Name)
+	assert.Equal(t, "Data Engineer", cv.Headline)
+	assert.Equal(t, "marco@example.com", cv.Contact.Email)
+	assert.Equal(t, "Milan, Italy", cv.Contact.Location)
+	require.Len(t, cv.Experience, 1)
+	assert.Equal(t, "Globex", cv.Experience[0].Company)
+	assert.Equal(t, 2019, cv.Experience[0].Dates.StartYear)
+	assert.True(t, cv.Experience[0].Dates.Current)
+	assert.Equal(t, []string{"Built Spark pipelines", "Maintained Airflow"}, cv.Experience[0].Bullets)
+	require.Len(t, cv.Education, 1)
+	assert.Equal(t, 2018, cv.Education[0].Dates.EndYear)
+	assert.Equal(t, []string{"Python", "Spark", "SQL"}, cv.Skills)
+	assert.Equal(t, []parse.Language{{Name: "Italian", Level: "Native"}, {Name: "English", Level: "C2"}}, cv.Languages)
+}
+
+func TestNewJSONResumeFromCV(t *testing.T) {
+	cv := parse.ParseCV(`# Jane Doe - Backend Engineer
+
+jane@example.com | https://github.com/janedoe
+Location: Berlin, Germany
+
+## Experience
+### Senior Engineer @ Acme Corp (Jan 2021 - Present)
+- Led migration to Kubernetes
+
+### Engineer at Initech | 03.2016 - 2020
+
+## Skills
+Go, PostgreSQL
+`)
+
+	resume := NewJSONResumeFromCV(cv)
+
+	assert.Equal(t, JSONResumeSchema, resume.Schema)
+	assert.Equal(t, "Jane Doe", resume.Basics.Name)
+	assert.Equal(t, "Backend Engineer", resume.Basics.Label)
+	assert.Equal(t, JSONResumeLocation{City: "Berlin", Region: "Germany"}, resume.Basics.Location)
+	assert.Equal(t, []JSONResumeProfile{{Network: "GitHub", URL: "https://github.com/janedoe"}}, resume.Basics.Profiles)
+
+	require.Len(t, resume.Work, 2)
+	assert.Equal(t, JSONResumeWork{Name: "Acme Corp", Position: "Senior Engineer", StartDate: "2021-01", Highlights: []string{"Led migration to Kubernetes"}}, resume.Work[0])
+	assert.Equal(t, "2016-03", resume.Work[1].StartDate)
+	assert.Equal(t, "2020", resume.Work[1].EndDate)
+	assert.Equal(t, []JSONResumeSkill{{Name: "Go"}, {Name: "PostgreSQL"}}, resume.Skills)
+}
+
+func TestMultiConverter(t *testing.T) {
+	m := NewMultiConverter(NewResumeConverter())
+
+	assert.True(t, m.IsAvailable())
+	assert.True(t, m.Supports(sampleJSONResume))
+	assert.False(t, m.Supports("plain text"))
+
+	_, err := m.Convert(context.Background(), "plain text")
+	assert.Error(t, err)
+}
@@ -12,19 +12,25 @@ type IngestorConfig struct {
 	StorageManager    *storage.StorageManager
 	DocumentConverter converter.DocumentConverter
 	Logger            *slog.Logger
+	// DuplicateThreshold is the near-duplicate similarity (0-1); defaults to storage.DefaultDuplicateThreshold
+	DuplicateThreshold float64
 }
 
 // NewIngestorWithConfig creates a new document ingestor with configuration
 func NewIngestorWithConfig(config IngestorConfig) *DocumentIngestor {
 	ingestor := &DocumentIngestor{
-		storageManager:    config.StorageManager,
-		documentConverter: config.DocumentConverter,
-		logger:            config.Logger,
+		storageManager:     config.StorageManager,
+		documentConverter:  config.DocumentConverter,
+		logger:             config.Logger,
+		duplicateThreshold: config.DuplicateThreshold,
 	}
 
 	if ingestor.logger == nil {
 		ingestor.logger = slog.Default()
 	}
+	if ingestor.duplicateThreshold == 0 {
+		ingestor.duplicateThreshold = storage.DefaultDuplicateThreshold
+	}
 
 	return ingestor
 }
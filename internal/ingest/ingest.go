@@ -12,6 +12,12 @@ import (
 	"github.com/kfreiman/vibecheck/internal/storage"
 )
 
+// structuredFilenames names raw structured CV text in storage metadata
+var structuredFilenames = map[converter.DocumentFormat]string{
+	converter.FormatJSONResume: "resume.json",
+	converter.FormatEuropass:   "europass.xml",
+}
+
 // Ingestor defines the interface for document ingestion
 type Ingestor interface {
 	// Ingest ingests a document from the given path and returns the URI
@@ -19,19 +25,27 @@ type Ingestor interface {
 	Ingest(ctx context.Context, path string, docType string) (string, error)
 }
 
+// DuplicateDetector is implemented by ingestors that can report near-duplicates of a stored document
+type DuplicateDetector interface {
+	// NearDuplicates returns other stored documents nearly identical to uri
+	NearDuplicates(uri string) ([]storage.SimilarDocument, error)
+}
+
 // DocumentIngestor implements the Ingestor interface
 type DocumentIngestor struct {
-	storageManager    *storage.StorageManager
-	documentConverter converter.DocumentConverter
-	logger            *slog.Logger
+	storageManager     *storage.StorageManager
+	documentConverter  converter.DocumentConverter
+	logger             *slog.Logger
+	duplicateThreshold float64
 }
 
 // NewIngestor creates a new document ingestor
 func NewIngestor(storageManager *storage.StorageManager, documentConverter converter.DocumentConverter) *DocumentIngestor {
 	return &DocumentIngestor{
-		storageManager:    storageManager,
-		documentConverter: documentConverter,
-		logger:            slog.Default(),
+		storageManager:     storageManager,
+		documentConverter:  documentConverter,
+		logger:             slog.Default(),
+		duplicateThreshold: storage.DefaultDuplicateThreshold,
 	}
 }
 
@@ -41,6 +55,17 @@ func (i *DocumentIngestor) WithLogger(logger *slog.Logger) *DocumentIngestor {
 	return i
 }
 
+// WithDuplicateThreshold sets the similarity above which ingested documents are reported as near-duplicates
+func (i *DocumentIngestor) WithDuplicateThreshold(threshold float64) *DocumentIngestor {
+	i.duplicateThreshold = threshold
+	return i
+}
+
+// NearDuplicates returns stored documents whose fingerprint is nearly identical to uri
+func (i *DocumentIngestor) NearDuplicates(uri string) ([]storage.SimilarDocument, error) {
+	return i.storageManager.FindNearDuplicates(uri, i.duplicateThreshold)
+}
+
 // Ingest implements the Ingestor interface
 func (i *DocumentIngestor) Ingest(ctx context.Context, path string, docType string) (string, error) {
 	// Validate type
@@ -67,6 +92,10 @@ func (i *DocumentIngestor) Ingest(ctx context.Context, path string, docType stri
 
 	// Extract filename for original name
 	originalFilename := extractFilename(path)
+	if info := converter.ParseInput(path); info.Type == converter.InputTypeText && info.Format != converter.FormatUnknown {
+		// Raw JSON Resume / Europass text has no filename of its own
+		originalFilename = structuredFilenames[info.Format]
+	}
 	if originalFilename == "" {
 		originalFilename = "document.md"
 	}
@@ -133,8 +162,19 @@ func (i *DocumentIngestor) getMarkdownContentWithRetry(ctx context.Context, path
 		}
 
 	case converter.InputTypeText:
-		// Raw text - no conversion needed
+		// Raw text - only structured CV formats (JSON Resume, Europass) need conversion
 		markdownContent = path
+		if inputInfo.Format != converter.FormatUnknown && i.documentConverter != nil && i.documentConverter.Supports(path) {
+			converted, convErr := i.documentConverter.Convert(ctx, path)
+			if convErr != nil {
+				i.logger.WarnContext(ctx, "structured CV conversion failed, storing raw text",
+					"format", inputInfo.Format,
+					"error", convErr,
+				)
+			} else {
+				markdownContent = converted
+			}
+		}
 
 	default:
 		return "", &ValidationError{
@@ -247,6 +247,27 @@ func TestDocumentIngestor_Deduplication(t *testing.T) {
 	assert.Equal(t, uri1, uri2, "Same content should return same URI")
 }
 
+func TestDocumentIngestor_JSONResume(t *testing.T) {
+	storageManager, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	ingestor := NewIngestor(storageManager, converter.NewResumeConverter())
+
+	// Raw JSON Resume text is converted to markdown before storage
+	resume := `{"basics": {"name": "Jane Doe", "email": "jane@example.com"}, "skills": [{"name": "Go"}]}`
+	uri, err := ingestor.Ingest(context.Background(), resume, "cv")
+	require.NoError(t, err)
+
+	content, err := storageManager.ReadDocument(uri)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "# Jane Doe")
+	assert.Contains(t, string(content), "## Skills\n- Go")
+	assert.NotContains(t, string(content), `"basics"`)
+}
+
 func TestDocumentIngestor_EmptyPath(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "ingest-empty-test-*")
 	require.NoError(t, err)
@@ -0,0 +1,265 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// AnalysisRecord is a stored analyze_cv_jd run: what was asked, how it was scored and what came back
+type AnalysisRecord struct {
+	URI           string                  `json:"uri"`
+	CreatedAt     time.Time               `json:"created_at"`
+	EngineVersion string                  `json:"engine_version"`
+	Inputs        AnalysisInputs          `json:"inputs"`
+	Weights       analysis.ScoringWeights `json:"weights"`
+	Result        AnalyzeResult           `json:"result"`
+}
+
+// AnalysisSummary is the listing entry of an analysis record
+type AnalysisSummary struct {
+	URI             string    `json:"uri"`
+	CreatedAt       time.Time `json:"created_at"`
+	CvURI           string    `json:"cv_uri"`
+	JdURI           string    `json:"jd_uri"`
+	WeightedScore   int       `json:"weighted_score"`
+	MatchPercentage int       `json:"match_percentage"`
+	ScreeningStatus string    `json:"screening_status,omitempty"`
+	EngineVersion   string    `json:"engine_version"`
+	Rank            int       `json:"rank,omitempty"` // Position by weighted score when ranked, 1 being the best
+}
+
+// recordAnalysis stores an analysis run and returns its analysis:// URI
+func (t *AnalyzeTool) recordAnalysis(args AnalysisInputs, weights analysis.ScoringWeights, result AnalyzeResult) (string, error) {
+	now := time.Now().UTC()
+	id := storage.NewAnalysisID(args.CvURI, args.JdURI, now)
+	record := AnalysisRecord{
+		URI:           storage.AnalysisScheme + id,
+		CreatedAt:     now,
+		EngineVersion: analysis.EngineVersion,
+		Inputs:        args,
+		Weights:       weights,
+		Result:        result,
+	}
+	record.Result.AnalysisURI = record.URI
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return t.storageManager.SaveAnalysis(id, data)
+}
+
+// readAnalysisRecord reads and decodes a stored analysis record
+func readAnalysisRecord(sm *storage.StorageManager, uri string) (AnalysisRecord, error) {
+	var record AnalysisRecord
+	data, err := sm.ReadAnalysis(uri)
+	if err != nil {
+		return record, err
+	}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return record, fmt.Errorf("decode analysis %s: %w", uri, err)
+	}
+	return record, nil
+}
+
+// AnalysisResourceHandler serves stored analyses as analysis:// resources
+type AnalysisResourceHandler struct {
+	storageManager *storage.StorageManager
+	logger         *slog.Logger
+}
+
+// NewAnalysisResourceHandler creates a new analysis resource handler
+func NewAnalysisResourceHandler(storageManager *storage.StorageManager) *AnalysisResourceHandler {
+	return &AnalysisResourceHandler{
+		storageManager: storageManager,
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the handler
+func (h *AnalysisResourceHandler) WithLogger(logger *slog.Logger) *AnalysisResourceHandler {
+	h.logger = logger
+	return h
+}
+
+// ReadResource returns the full analysis record as JSON
+func (h *AnalysisResourceHandler) ReadResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	data, err := h.storageManager.ReadAnalysis(uri)
+	if err != nil {
+		h.logger.DebugContext(ctx, "failed to read analysis",
+			"uri", uri,
+			"error", err,
+		)
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      uri,
+			MIMEType: "application/json",
+			Text:     string(data),
+		}},
+	}, nil
+}
+
+// ListResourceTemplates returns the analysis:// resource template
+func (h *AnalysisResourceHandler) ListResourceTemplates() []mcp.ResourceTemplate {
+	return []mcp.ResourceTemplate{
+		{
+			URITemplate: "analysis://{id}",
+			Name:        "Stored Analysis",
+			Description: "A past analyze_cv_jd run: inputs, scoring weights, engine version, timestamp and the full result",
+			MIMEType:    "application/json",
+		},
+	}
+}
+
+// ListAnalysesTool lists stored analyses
+type ListAnalysesTool struct {
+	storageManager *storage.StorageManager
+	logger         *slog.Logger
+}
+
+// NewListAnalysesTool creates a new list analyses tool
+func NewListAnalysesTool(storageManager *storage.StorageManager) *ListAnalysesTool {
+	return &ListAnalysesTool{
+		storageManager: storageManager,
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *ListAnalysesTool) WithLogger(logger *slog.Logger) *ListAnalysesTool {
+	t.logger = logger
+	return t
+}
+
+// ListAnalysesResult is the output of list_analyses
+type ListAnalysesResult struct {
+	Analyses []AnalysisSummary `json:"analyses"`
+}
+
+// Call implements the MCP tool interface
+func (t *ListAnalysesTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		CvURI           string   `json:"cv_uri"`           // Optional: only analyses of this CV
+		JdURI           string   `json:"jd_uri"`           // Optional: only analyses against this JD
+		ScreeningStatus []string `json:"screening_status"` // Optional: only analyses with one of these screening statuses
+		Rank            bool     `json:"rank"`             // Optional: latest analysis per CV/JD pair, best weighted score first
+		Limit           int      `json:"limit"`            // Optional: maximum number of entries, newest first
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if args.Limit < 0 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Error: 'limit' must not be negative"},
+			},
+		}, &ValidationError{Field: "limit", Value: fmt.Sprint(args.Limit), Reason: "must not be negative"}
+	}
+	for _, status := range args.ScreeningStatus {
+		if status != analysis.CheckPass && status != analysis.CheckFail && status != analysis.CheckReview {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("Error: screening_status - unknown status %q (use pass, fail or needs_review)", status)},
+				},
+			}, &ValidationError{Field: "screening_status", Value: status, Reason: "must be pass, fail or needs_review"}
+		}
+	}
+
+	uris, err := t.storageManager.ListAnalyses()
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error listing analyses: %v", err)},
+			},
+		}, err
+	}
+
+	result := ListAnalysesResult{Analyses: []AnalysisSummary{}}
+	seen := make(map[string]bool)
+	for _, uri := range uris {
+		// Ranking needs every matching analysis before the limit applies
+		if !args.Rank && args.Limit > 0 && len(result.Analyses) >= args.Limit {
+			break
+		}
+		record, err := readAnalysisRecord(t.storageManager, uri)
+		if err != nil {
+			t.logger.DebugContext(ctx, "skipping unreadable analysis", "uri", uri, "error", err)
+			continue
+		}
+		if args.CvURI != "" && record.Inputs.CvURI != args.CvURI || args.JdURI != "" && record.Inputs.JdURI != args.JdURI {
+			continue
+		}
+		// Analyses are newest first, so the first one of a pair is its latest
+		pair := record.Inputs.CvURI + "|" + record.Inputs.JdURI
+		if args.Rank && seen[pair] {
+			continue
+		}
+		seen[pair] = true
+		if len(args.ScreeningStatus) > 0 && !containsStatus(args.ScreeningStatus, record.Result.ScreeningStatus) {
+			continue
+		}
+		result.Analyses = append(result.Analyses, AnalysisSummary{
+			URI:             record.URI,
+			CreatedAt:       record.CreatedAt,
+			CvURI:           record.Inputs.CvURI,
+			JdURI:           record.Inputs.JdURI,
+			WeightedScore:   record.Result.WeightedScore,
+			MatchPercentage: record.Result.MatchPercentage,
+			ScreeningStatus: record.Result.ScreeningStatus,
+			EngineVersion:   record.EngineVersion,
+		})
+	}
+	if args.Rank {
+		sort.SliceStable(result.Analyses, func(i, j int) bool {
+			return result.Analyses[i].WeightedScore > result.Analyses[j].WeightedScore
+		})
+		if args.Limit > 0 && len(result.Analyses) > args.Limit {
+			result.Analyses = result.Analyses[:args.Limit]
+		}
+		for i := range result.Analyses {
+			result.Analyses[i].Rank = i + 1
+		}
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
+
+// containsStatus reports whether status is one of statuses
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalysisHistory(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\nGo developer with Docker.\n"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Go Developer\n\nWe need Go and Kubernetes.\n"), "jd.md")
+	require.NoError(t, err)
+	otherJD, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Python Developer\n\nWe need Python.\n"), "jd2.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm).WithHistory(true)
+	analyze := func(jd string) AnalyzeResult {
+		text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jd, "rules": "skill Go"})
+		require.NoError(t, err)
+		var result AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		return result
+	}
+
+	first := analyze(jdURI)
+	require.NotEmpty(t, first.AnalysisURI)
+	second := analyze(otherJD)
+	assert.NotEqual(t, first.AnalysisURI, second.AnalysisURI)
+
+	t.Run("resource returns the full record", func(t *testing.T) {
+		handler := NewAnalysisResourceHandler(sm)
+		res, err := handler.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: first.AnalysisURI},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Contents, 1)
+		assert.Equal(t, "application/json", res.Contents[0].MIMEType)
+
+		var record AnalysisRecord
+		require.NoError(t, json.Unmarshal([]byte(res.Contents[0].Text), &record))
+		assert.Equal(t, first.AnalysisURI, record.URI)
+		assert.Equal(t, analysis.EngineVersion, record.EngineVersion)
+		assert.Equal(t, cvURI, record.Inputs.CvURI)
+		assert.Equal(t, "skill Go", record.Inputs.Rules)
+		assert.Equal(t, analysis.NewDefaultWeights(), record.Weights)
+		assert.Equal(t, first.WeightedScore, record.Result.WeightedScore)
+		assert.False(t, record.CreatedAt.IsZero())
+
+		_, err = handler.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: "analysis://missing"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("list filters by document", func(t *testing.T) {
+		list := NewListAnalysesTool(sm)
+		listed := func(args map[string]interface{}) []AnalysisSummary {
+			text, err := callTool(t, list.Call, args)
+			require.NoError(t, err)
+			var result ListAnalysesResult
+			require.NoError(t, json.Unmarshal([]byte(text), &result))
+			return result.Analyses
+		}
+
+		all := listed(map[string]interface{}{"cv_uri": cvURI})
+		require.Len(t, all, 2)
+
+		byJD := listed(map[string]interface{}{"jd_uri": otherJD})
+		require.Len(t, byJD, 1)
+		assert.Equal(t, second.AnalysisURI, byJD[0].URI)
+		assert.Equal(t, "pass", byJD[0].ScreeningStatus)
+
+		assert.Len(t, listed(map[string]interface{}{"limit": 1}), 1)
+		assert.Empty(t, listed(map[string]interface{}{"cv_uri": "cv://unknown"}))
+	})
+}
+
+func TestAnalyzeTool_Call_NoHistory(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\nGo developer.\n"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Go Developer\n\nWe need Go.\n"), "jd.md")
+	require.NoError(t, err)
+
+	text, err := callTool(t, NewAnalyzeTool(sm).Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+	var result AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &result))
+	assert.Empty(t, result.AnalysisURI)
+
+	uris, err := sm.ListAnalyses()
+	require.NoError(t, err)
+	assert.Empty(t, uris)
+}
@@ -14,6 +14,7 @@ import (
 type AnalyzeFitPrompt struct {
 	storageManager *storage.StorageManager
 	logger         *slog.Logger
+	blindScreening bool
 }
 
 // NewAnalyzeFitPrompt creates a new analyze fit prompt
@@ -30,6 +31,12 @@ func (p *AnalyzeFitPrompt) WithLogger(logger *slog.Logger) *AnalyzeFitPrompt {
 	return p
 }
 
+// WithBlindScreening always points the prompt at the anonymized CV
+func (p *AnalyzeFitPrompt) WithBlindScreening(blind bool) *AnalyzeFitPrompt {
+	p.blindScreening = blind
+	return p
+}
+
 // Handle implements the prompt handler interface
 func (p *AnalyzeFitPrompt) Handle(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
 	args := req.Params.Arguments
@@ -65,6 +72,9 @@ func (p *AnalyzeFitPrompt) Handle(ctx context.Context, req *mcp.GetPromptRequest
 
 	// Build the analysis prompt
 	prompt := BuildAnalyzeFitPrompt(cvURI, jdURI)
+	if p.blindScreening || args["blind"] == "true" {
+		prompt = BuildBlindAnalyzeFitPrompt(cvURI, jdURI)
+	}
 
 	return &mcp.GetPromptResult{
 		Description: "Analyze CV and Job Description fit",
@@ -135,6 +145,20 @@ Provide 2-3 specific suggestions for:
 Your response should be clear and well-organized with markdown formatting. Use headings for each section above.`, cvURI, jdURI)
 }
 
+// BuildBlindAnalyzeFitPrompt creates the analysis prompt for blind screening.
+// It references the anonymized cv://{id}/blind resource so the raw CV never reaches the client.
+func BuildBlindAnalyzeFitPrompt(cvURI, jdURI string) string {
+	blindURI := cvURI + "/" + ResourceViewBlind
+	prompt := BuildAnalyzeFitPrompt(blindURI, jdURI)
+	return prompt + `
+
+## Blind Screening
+
+This is an anonymized first-round screening. The CV has names, gendered pronouns, age and birth dates,
+photos, nationality, marital status and graduation years masked. Read the CV only through ` + blindURI + `,
+do not try to infer any masked attribute, and base the assessment solely on skills and experience.`
+}
+
 // BuildQuickAnalysisPrompt creates a concise analysis prompt for quick reviews
 func BuildQuickAnalysisPrompt(cvURI, jdURI string) string {
 	return fmt.Sprintf(`Quick analysis: Compare CV (%s) against job description (%s).
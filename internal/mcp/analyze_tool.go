@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/kfreiman/vibecheck/internal/analysis"
 	"github.com/kfreiman/vibecheck/internal/storage"
@@ -18,6 +19,11 @@ type AnalyzeTool struct {
 	storageManager *storage.StorageManager
 	engine         *analysis.AnalysisEngine
 	logger         *slog.Logger
+	blindScreening bool
+	cache          bool
+	history        bool
+	calibration    bool
+	calibrationMu  sync.Mutex // Serializes read-modify-write of score distributions
 }
 
 // NewAnalyzeTool creates a new analyze tool
@@ -35,17 +41,85 @@ func (t *AnalyzeTool) WithLogger(logger *slog.Logger) *AnalyzeTool {
 	return t
 }
 
+// WithBlindScreening anonymizes every CV before analysis, regardless of the blind argument
+func (t *AnalyzeTool) WithBlindScreening(blind bool) *AnalyzeTool {
+	t.blindScreening = blind
+	return t
+}
+
+// WithRecencyHalfLife sets the years after which an unused CV skill counts half (<= 0 disables)
+func (t *AnalyzeTool) WithRecencyHalfLife(years float64) *AnalyzeTool {
+	t.engine.WithRecencyHalfLife(years)
+	return t
+}
+
+// WithAnalysisCache stores results on disk keyed by document IDs, options and the engine
+// fingerprint, and returns stored results for repeated analyses
+func (t *AnalyzeTool) WithAnalysisCache(enabled bool) *AnalyzeTool {
+	t.cache = enabled
+	return t
+}
+
+// WithHistory stores every analysis as an analysis:// record
+func (t *AnalyzeTool) WithHistory(enabled bool) *AnalyzeTool {
+	t.history = enabled
+	return t
+}
+
+// WithCalibration records every weighted score per JD and JD family and returns its percentile
+// among the applicants scored so far
+func (t *AnalyzeTool) WithCalibration(enabled bool) *AnalyzeTool {
+	t.calibration = enabled
+	return t
+}
+
+// WithSalaryConfig sets the base currency, exchange rates and net ratio for salary comparison
+func (t *AnalyzeTool) WithSalaryConfig(cfg analysis.SalaryConfig) *AnalyzeTool {
+	t.engine.WithSalaryConfig(cfg)
+	return t
+}
+
 // AnalyzeResult represents the structured analysis output
 type AnalyzeResult struct {
-	MatchPercentage  int             `json:"match_percentage"`
-	WeightedScore    int             `json:"weighted_score"`
-	SkillCoverage    float64         `json:"skill_coverage"`
-	ExperienceMatch  float64         `json:"experience_match"`
-	TopSkills        []string        `json:"top_skills"`
-	MissingSkills    []string        `json:"missing_skills"`
-	PresentSkills    []string        `json:"present_skills"`
-	ScoringBreakdown *ScoreBreakdown `json:"scoring_breakdown"`
-	AnalysisSummary  string          `json:"analysis_summary"`
+	MatchPercentage      int                          `json:"match_percentage"`
+	WeightedScore        int                          `json:"weighted_score"`
+	SkillCoverage        float64                      `json:"skill_coverage"`
+	ExperienceMatch      float64                      `json:"experience_match"`
+	ProficiencyAlignment float64                      `json:"proficiency_alignment"`
+	TopSkills            []string                     `json:"top_skills"`
+	MissingSkills        []string                     `json:"missing_skills"`
+	PresentSkills        []string                     `json:"present_skills"`
+	SkillRecency         []analysis.SkillRecency      `json:"skill_recency"`
+	NegatedSkills        []string                     `json:"negated_skills,omitempty"`
+	SkillVersions        []analysis.SkillVersionMatch `json:"skill_versions,omitempty"`
+	LanguageRequirements []analysis.LanguageMatch     `json:"language_requirements,omitempty"`
+	LanguagesMet         bool                         `json:"languages_met"`
+	CVConstraints        *analysis.WorkConstraints    `json:"cv_constraints,omitempty"`
+	JDConstraints        *analysis.WorkConstraints    `json:"jd_constraints,omitempty"`
+	Constraints          []analysis.ConstraintCheck   `json:"constraints,omitempty"`
+	ConstraintsMet       bool                         `json:"constraints_met"`
+	Salary               *analysis.SalaryMatch        `json:"salary,omitempty"`
+	Rules                []analysis.RuleResult        `json:"rules,omitempty"`
+	ScreeningStatus      string                       `json:"screening_status,omitempty"`
+	ScoringBreakdown     *ScoreBreakdown              `json:"scoring_breakdown"`
+	Explanation          *analysis.ScoreExplanation   `json:"explanation,omitempty"`
+	AnalysisSummary      string                       `json:"analysis_summary"`
+	Blind                bool                         `json:"blind"`
+	Calibration          []analysis.ScoreCalibration  `json:"calibration,omitempty"`
+	Cached               bool                         `json:"cached,omitempty"`
+	AnalysisURI          string                       `json:"analysis_uri,omitempty"`
+}
+
+// AnalysisInputs are the analyze_cv_jd arguments
+type AnalysisInputs struct {
+	CvURI      string   `json:"cv_uri"`
+	JdURI      string   `json:"jd_uri"`
+	Blind      bool     `json:"blind"`
+	Explain    bool     `json:"explain,omitempty"`
+	Rules      string   `json:"rules,omitempty"`
+	RuleStatus []string `json:"rule_status,omitempty"`
+	Profile    string   `json:"profile,omitempty"`
+	Family     string   `json:"family,omitempty"`
 }
 
 // ScoreBreakdown represents the detailed scoring breakdown
@@ -54,16 +128,14 @@ type ScoreBreakdown struct {
 	Experience     float64 `json:"experience_match"`
 	TermSimilarity float64 `json:"term_similarity"`
 	OverallMatch   float64 `json:"overall_match"`
+	Proficiency    float64 `json:"proficiency_alignment"`
 	WeightedTotal  int     `json:"weighted_total"`
 }
 
 // Call implements the MCP tool interface
 func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	// Parse arguments
-	var args struct {
-		CvURI string `json:"cv_uri"`
-		JdURI string `json:"jd_uri"`
-	}
+	var args AnalysisInputs
 
 	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
 		return &mcp.CallToolResult{
@@ -108,6 +180,48 @@ func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*
 		}, &ValidationError{Field: "jd_uri", Value: args.JdURI, Reason: "must be jd:// format"}
 	}
 
+	// Without rules in the call, screen with the rules stored for the JD
+	if args.Rules == "" {
+		stored, err := readScreeningRules(t.storageManager, args.JdURI)
+		if err != nil {
+			t.logger.WarnContext(ctx, "failed to read stored screening rules", "error", err, "jd_uri", args.JdURI)
+		}
+		args.Rules = stored
+	}
+
+	// Parse screening rules before reading documents
+	rules, err := analysis.ParseRules(args.Rules)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: rules - %v", err)},
+			},
+		}, &ValidationError{Field: "rules", Value: args.Rules, Reason: err.Error()}
+	}
+	for _, status := range args.RuleStatus {
+		if status != analysis.CheckPass && status != analysis.CheckFail && status != analysis.CheckReview {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("Error: rule_status - unknown status %q (use pass, fail or needs_review)", status)},
+				},
+			}, &ValidationError{Field: "rule_status", Value: status, Reason: "must be pass, fail or needs_review"}
+		}
+	}
+
+	// Score with a trained profile's weights when one is named
+	engine := t.engine
+	if args.Profile != "" {
+		profile, err := readScoringProfile(t.storageManager, args.Profile)
+		if err != nil {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("Error: profile - %v", err)},
+				},
+			}, &ValidationError{Field: "profile", Value: args.Profile, Reason: "scoring profile not found"}
+		}
+		engine = t.engine.Clone().WithWeights(profile.Weights)
+	}
+
 	// Check if documents exist
 	if !t.storageManager.DocumentExists(args.CvURI) {
 		return &mcp.CallToolResult{
@@ -124,6 +238,15 @@ func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*
 		}, &ValidationError{Field: "jd_uri", Value: args.JdURI, Reason: "document not found"}
 	}
 
+	blind := args.Blind || t.blindScreening
+	cacheKey := t.cacheKey(engine, blind, args.Explain, args.Rules, args.RuleStatus)
+	if t.cache {
+		if result, ok := t.cachedResult(args.CvURI, args.JdURI, cacheKey); ok {
+			t.logger.DebugContext(ctx, "returning cached analysis", "cv_uri", args.CvURI, "jd_uri", args.JdURI)
+			return t.respond(ctx, engine, args, result)
+		}
+	}
+
 	// Read documents from storage
 	cvContent, err := t.storageManager.ReadDocument(args.CvURI)
 	if err != nil {
@@ -147,8 +270,14 @@ func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*
 	cvClean := stripFrontmatter(string(cvContent))
 	jdClean := stripFrontmatter(string(jdContent))
 
+	// Mask bias-prone attributes before the CV reaches scoring
+	if blind {
+		cvClean = analysis.Anonymize(cvClean)
+		t.logger.DebugContext(ctx, "anonymized CV for blind screening", "cv_uri", args.CvURI)
+	}
+
 	// Perform BM25 analysis
-	analysisResult, err := t.engine.Analyze(ctx, cvClean, jdClean)
+	analysisResult, err := engine.AnalyzeWithRules(ctx, cvClean, jdClean, rules)
 	if err != nil {
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -157,6 +286,16 @@ func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*
 		}, err
 	}
 
+	// Attribute the score to components, skills and terms; this rescores once per factor, so only on request
+	if args.Explain {
+		if analysisResult.Explanation, err = engine.Explain(ctx, cvClean, jdClean, analysisResult); err != nil {
+			t.logger.WarnContext(ctx, "failed to explain score", "error", err, "cv_uri", args.CvURI, "jd_uri", args.JdURI)
+		}
+	}
+
+	// The screening status covers every rule; the filter only narrows what is listed
+	analysisResult.Rules = analysis.RuleResultsWithStatus(analysisResult.Rules, args.RuleStatus)
+
 	// Build analysis summary
 	summary := t.buildSummary(analysisResult)
 
@@ -168,24 +307,64 @@ func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*
 			Experience:     analysisResult.ScoringBreakdown.Experience,
 			TermSimilarity: analysisResult.ScoringBreakdown.TermSimilarity,
 			OverallMatch:   analysisResult.ScoringBreakdown.OverallMatch,
+			Proficiency:    analysisResult.ScoringBreakdown.Proficiency,
 			WeightedTotal:  analysisResult.ScoringBreakdown.WeightedTotal,
 		}
 	}
 
 	// Create structured result
 	result := AnalyzeResult{
-		MatchPercentage:  analysisResult.MatchPercentage,
-		WeightedScore:    analysisResult.WeightedScore,
-		SkillCoverage:    analysisResult.SkillCoverage,
-		ExperienceMatch:  analysisResult.ExperienceMatch,
-		TopSkills:        analysisResult.TopSkills,
-		MissingSkills:    analysisResult.MissingSkills,
-		PresentSkills:    analysisResult.PresentSkills,
-		ScoringBreakdown: scoringBreakdown,
-		AnalysisSummary:  summary,
-	}
-
-	// Return as structured JSON
+		MatchPercentage:      analysisResult.MatchPercentage,
+		WeightedScore:        analysisResult.WeightedScore,
+		SkillCoverage:        analysisResult.SkillCoverage,
+		ExperienceMatch:      analysisResult.ExperienceMatch,
+		ProficiencyAlignment: analysisResult.ProficiencyAlignment,
+		TopSkills:            analysisResult.TopSkills,
+		MissingSkills:        analysisResult.MissingSkills,
+		PresentSkills:        analysisResult.PresentSkills,
+		SkillRecency:         analysisResult.SkillRecency,
+		NegatedSkills:        analysisResult.NegatedSkills,
+		SkillVersions:        analysisResult.SkillVersions,
+		LanguageRequirements: analysisResult.LanguageRequirements,
+		LanguagesMet:         analysisResult.LanguagesMet,
+		CVConstraints:        analysisResult.CVConstraints,
+		JDConstraints:        analysisResult.JDConstraints,
+		Constraints:          analysisResult.Constraints,
+		ConstraintsMet:       analysisResult.ConstraintsMet,
+		Salary:               analysisResult.Salary,
+		Rules:                analysisResult.Rules,
+		ScreeningStatus:      analysisResult.ScreeningStatus,
+		ScoringBreakdown:     scoringBreakdown,
+		Explanation:          analysisResult.Explanation,
+		AnalysisSummary:      summary,
+		Blind:                blind,
+	}
+
+	if t.cache {
+		if jsonData, err := json.Marshal(result); err == nil {
+			if err := t.storageManager.WriteCachedAnalysis(args.CvURI, args.JdURI, cacheKey, jsonData); err != nil {
+				t.logger.WarnContext(ctx, "failed to cache analysis", "error", err, "cv_uri", args.CvURI, "jd_uri", args.JdURI)
+			}
+		}
+	}
+
+	return t.respond(ctx, engine, args, result)
+}
+
+// respond calibrates the score, records the analysis in the history and returns it as structured JSON
+func (t *AnalyzeTool) respond(ctx context.Context, engine *analysis.AnalysisEngine, args AnalysisInputs, result AnalyzeResult) (*mcp.CallToolResult, error) {
+	if t.calibration {
+		result.Calibration = t.calibrate(ctx, engine, args, result.Blind, result.WeightedScore)
+		result.AnalysisSummary += calibrationSummary(result.Calibration)
+	}
+	if t.history {
+		uri, err := t.recordAnalysis(args, engine.Weights(), result)
+		if err != nil {
+			t.logger.WarnContext(ctx, "failed to record analysis", "error", err, "cv_uri", args.CvURI, "jd_uri", args.JdURI)
+		}
+		result.AnalysisURI = uri
+	}
+
 	jsonData, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return &mcp.CallToolResult{
@@ -202,6 +381,26 @@ func (t *AnalyzeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*
 	}, nil
 }
 
+// cacheKey identifies the analysis options and engine state a cached result was computed with
+func (t *AnalyzeTool) cacheKey(engine *analysis.AnalysisEngine, blind, explain bool, rules string, ruleStatus []string) string {
+	options := fmt.Sprintf("%s|%t|%t|%s|%s", engine.Fingerprint(), blind, explain, rules, strings.Join(ruleStatus, ","))
+	return storage.GenerateIDFromString(options)[:16]
+}
+
+// cachedResult returns a stored analysis marked as cached
+func (t *AnalyzeTool) cachedResult(cvURI, jdURI, key string) (AnalyzeResult, bool) {
+	var result AnalyzeResult
+	data, ok := t.storageManager.ReadCachedAnalysis(cvURI, jdURI, key)
+	if !ok {
+		return result, false
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, false
+	}
+	result.Cached = true
+	return result, true
+}
+
 // stripFrontmatter removes YAML frontmatter (--- delimited) from content
 func stripFrontmatter(content string) string {
 	// Remove YAML frontmatter between --- markers
@@ -223,29 +422,136 @@ func (t *AnalyzeTool) buildSummary(result *analysis.AnalysisResult) string {
 	sb.WriteString(fmt.Sprintf("  Weighted Score: %d/100\n", result.WeightedScore))
 	sb.WriteString(fmt.Sprintf("  Skill Coverage: %.1f%%\n", result.SkillCoverage*100))
 	sb.WriteString(fmt.Sprintf("  Experience Match: %.1f%%\n", result.ExperienceMatch*100))
+	sb.WriteString(fmt.Sprintf("  Proficiency Alignment: %.1f%%\n", result.ProficiencyAlignment*100))
 	sb.WriteString("\n")
 
 	// Scoring breakdown
 	if result.ScoringBreakdown != nil {
+		weights := result.Weights
+		if weights == (analysis.ScoringWeights{}) {
+			weights = analysis.NewDefaultWeights()
+		}
 		sb.WriteString("Scoring Breakdown:\n")
-		sb.WriteString(fmt.Sprintf("  Skill Coverage (40%%): %.1f%%\n", result.ScoringBreakdown.SkillCoverage*100))
-		sb.WriteString(fmt.Sprintf("  Experience (30%%): %.1f%%\n", result.ScoringBreakdown.Experience*100))
-		sb.WriteString(fmt.Sprintf("  Term Similarity (20%%): %.1f%%\n", result.ScoringBreakdown.TermSimilarity*100))
-		sb.WriteString(fmt.Sprintf("  Overall Match (10%%): %.1f%%\n", result.ScoringBreakdown.OverallMatch*100))
+		sb.WriteString(fmt.Sprintf("  Skill Coverage (%.0f%%): %.1f%%\n", weights.SkillCoverage*100, result.ScoringBreakdown.SkillCoverage*100))
+		sb.WriteString(fmt.Sprintf("  Experience (%.0f%%): %.1f%%\n", weights.Experience*100, result.ScoringBreakdown.Experience*100))
+		sb.WriteString(fmt.Sprintf("  Term Similarity (%.0f%%): %.1f%%\n", weights.TermSimilarity*100, result.ScoringBreakdown.TermSimilarity*100))
+		sb.WriteString(fmt.Sprintf("  Overall Match (%.0f%%): %.1f%%\n", weights.OverallMatch*100, result.ScoringBreakdown.OverallMatch*100))
+		sb.WriteString(fmt.Sprintf("  Proficiency (%.0f%%): %.1f%%\n", weights.Proficiency*100, result.ScoringBreakdown.Proficiency*100))
+		sb.WriteString("\n")
+	}
+
+	if result.Explanation != nil {
+		sb.WriteString(fmt.Sprintf("Score Explanation (%.1f points):\n", result.Explanation.Score))
+		for _, c := range result.Explanation.Components {
+			sb.WriteString(fmt.Sprintf("  %s: %.1f points (%.0f%% x %.0f%%)\n", c.Component, c.Points, c.Value*100, c.Weight*100))
+			for _, f := range c.Factors {
+				state := "present"
+				if !f.Present {
+					state = "missing"
+				}
+				sb.WriteString(fmt.Sprintf("    %+.1f %s %s (%s)\n", f.Delta, f.Kind, f.Name, state))
+			}
+		}
+		if best := result.Explanation.BestImprovement; best != nil {
+			sb.WriteString(fmt.Sprintf("  Largest improvement: add %s %s (%+.1f points)\n", best.Kind, best.Name, -best.Delta))
+		}
 		sb.WriteString("\n")
 	}
 
 	// Skills
 	if len(result.PresentSkills) > 0 {
+		lastUsed := make(map[string]int, len(result.SkillRecency))
+		for _, sr := range result.SkillRecency {
+			lastUsed[sr.Skill] = sr.LastUsed
+		}
 		sb.WriteString("Present Skills (CV):\n")
 		for i, skill := range result.PresentSkills {
 			if i < 10 { // Show top 10
-				sb.WriteString(fmt.Sprintf("  %d. %s\n", i+1, skill))
+				if year := lastUsed[skill]; year > 0 {
+					sb.WriteString(fmt.Sprintf("  %d. %s (last used %d)\n", i+1, skill, year))
+				} else {
+					sb.WriteString(fmt.Sprintf("  %d. %s\n", i+1, skill))
+				}
 			}
 		}
 		sb.WriteString("\n")
 	}
 
+	if len(result.NegatedSkills) > 0 {
+		sb.WriteString("Negated Mentions (CV says no experience):\n")
+		for _, skill := range result.NegatedSkills {
+			sb.WriteString(fmt.Sprintf("  - %s\n", skill))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(result.SkillVersions) > 0 {
+		sb.WriteString("Version Requirements:\n")
+		for _, sv := range result.SkillVersions {
+			claimed, match := sv.Claimed, sv.Match
+			if claimed == "" {
+				claimed, match = "unspecified", "unknown"
+			}
+			sb.WriteString(fmt.Sprintf("  %s: requires %s, CV has %s (%s)\n", sv.Skill, sv.Required, claimed, match))
+		}
+		sb.WriteString("\n")
+	}
+
+	if result.ScreeningStatus != "" {
+		sb.WriteString(fmt.Sprintf("Screening Rules (%s):\n", strings.ToUpper(result.ScreeningStatus)))
+		for _, r := range result.Rules {
+			sb.WriteString(fmt.Sprintf("  %s: %s - %s\n", r.Rule, r.Status, r.Reason))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(result.LanguageRequirements) > 0 {
+		status := "PASS"
+		if !result.LanguagesMet {
+			status = "FAIL"
+		}
+		sb.WriteString(fmt.Sprintf("Language Requirements (%s):\n", status))
+		for _, lm := range result.LanguageRequirements {
+			mark := "pass"
+			if !lm.Pass {
+				mark = "fail"
+			}
+			required := lm.Required
+			if required == "" {
+				required = "any level"
+			}
+			if lm.Requirement == analysis.RequirementPreferred {
+				required += ", preferred"
+			}
+			sb.WriteString(fmt.Sprintf("  %s (%s): %s - %s\n", lm.Language, required, mark, lm.Reason))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(result.Constraints) > 0 {
+		status := "PASS"
+		if !result.ConstraintsMet {
+			status = "FAIL"
+		}
+		sb.WriteString(fmt.Sprintf("Work Constraints (%s):\n", status))
+		for _, c := range result.Constraints {
+			sb.WriteString(fmt.Sprintf("  %s: %s - %s\n", c.Constraint, c.Status, c.Reason))
+		}
+		sb.WriteString("\n")
+	}
+
+	if result.Salary != nil {
+		sb.WriteString("Salary:\n")
+		if result.Salary.JD != nil {
+			sb.WriteString(fmt.Sprintf("  JD band: %s\n", result.Salary.JD.Text))
+		}
+		if result.Salary.CV != nil {
+			sb.WriteString(fmt.Sprintf("  CV expectation: %s\n", result.Salary.CV.Text))
+		}
+		sb.WriteString(fmt.Sprintf("  %s - %s\n", result.Salary.Status, result.Salary.Reason))
+		sb.WriteString("\n")
+	}
+
 	if len(result.TopSkills) > 0 {
 		sb.WriteString("Top Matching Skills:\n")
 		for i, skill := range result.TopSkills {
@@ -389,3 +389,263 @@ func TestAnalyzeTool_buildSummary(t *testing.T) {
 	assert.Contains(t, summary, "java")
 	assert.Contains(t, summary, "rust")
 }
+
+func TestAnalyzeTool_Call_Blind(t *testing.T) {
+	tempDir := t.TempDir()
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   tempDir,
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\nShe writes golang and python."), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("golang python"), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm)
+	argsJSON, err := json.Marshal(map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "blind": true})
+	require.NoError(t, err)
+
+	result, err := tool.Call(context.Background(), &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Arguments: json.RawMessage(argsJSON)},
+	})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok, "expected TextContent")
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &analyzeResult))
+
+	assert.True(t, analyzeResult.Blind)
+	assert.Equal(t, 1.0, analyzeResult.SkillCoverage)
+	assert.NotContains(t, textContent.Text, "jane")
+	assert.NotContains(t, textContent.Text, "Jane")
+}
+
+func TestAnalyzeTool_Call_SkillRecency(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Experience\n### Developer @ Initech (2010 - 2012)\n- Maintained a PHP monolith\n"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("PHP developer"), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm)
+	text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &analyzeResult))
+	require.NotEmpty(t, analyzeResult.SkillRecency)
+	assert.Equal(t, "php", analyzeResult.SkillRecency[0].Skill)
+	assert.Equal(t, 2012, analyzeResult.SkillRecency[0].LastUsed)
+	assert.Less(t, analyzeResult.SkillRecency[0].Recency, 1.0)
+	assert.Contains(t, analyzeResult.AnalysisSummary, "php (last used 2012)")
+}
+
+func TestAnalyzeTool_Call_LanguageRequirements(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Skills\nGo\n\n## Languages\n- English (B1)\n"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("Go developer. English B2+ required."), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm)
+	text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &analyzeResult))
+	require.Len(t, analyzeResult.LanguageRequirements, 1)
+	assert.Equal(t, "English", analyzeResult.LanguageRequirements[0].Language)
+	assert.False(t, analyzeResult.LanguageRequirements[0].Pass)
+	assert.False(t, analyzeResult.LanguagesMet)
+	assert.Contains(t, analyzeResult.AnalysisSummary, "Language Requirements (FAIL)")
+	assert.Contains(t, analyzeResult.AnalysisSummary, "English (B2): fail - B1 below required B2")
+}
+
+func TestAnalyzeTool_Call_Constraints(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cv := "# Ana Souza\nLocation: São Paulo, Brazil\n\n## Summary\nGo developer looking for remote only roles.\n"
+	jd := "# Go Developer\n\nLocation: Berlin\n\nOnsite role in our Berlin office. No visa sponsorship.\n"
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(cv), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte(jd), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm)
+	text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &analyzeResult))
+	assert.False(t, analyzeResult.ConstraintsMet)
+	require.NotNil(t, analyzeResult.CVConstraints)
+	assert.Equal(t, []string{"remote"}, analyzeResult.CVConstraints.WorkModes)
+	assert.Contains(t, analyzeResult.AnalysisSummary, "Work Constraints (FAIL)")
+	assert.Contains(t, analyzeResult.AnalysisSummary, "work_mode: fail")
+}
+
+func TestAnalyzeTool_Call_Salary(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cv := "# Ivan Petrov\n\n## Summary\nGo developer.\n\nЗарплатные ожидания: 300 000 ₽ на руки\n"
+	jd := "# Go Developer\n\nWe need Go.\n\nSalary: $40,000 - $50,000 per year\n"
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(cv), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte(jd), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm).WithSalaryConfig(Config{}.
+		WithSalaryCurrency("usd").
+		WithSalaryRates(map[string]float64{"rub": 0.011}).
+		WithSalaryNetRatio(0.87).
+		SalaryConfig())
+	text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &analyzeResult))
+	require.NotNil(t, analyzeResult.Salary)
+	require.NotNil(t, analyzeResult.Salary.CV)
+	assert.True(t, analyzeResult.Salary.CV.Net)
+	assert.Equal(t, "USD", analyzeResult.Salary.Currency)
+	assert.Equal(t, "within", analyzeResult.Salary.Status)
+	assert.Contains(t, analyzeResult.AnalysisSummary, "Salary:")
+}
+
+func TestAnalyzeTool_Call_Explanation(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cv := "# Jane Doe\n\n## Skills\nPostgreSQL, Docker\n"
+	jd := "# Backend Engineer\n\n## Requirements\n- PostgreSQL\n- Kubernetes\n"
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(cv), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte(jd), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm).WithAnalysisCache(true)
+
+	// Off by default: explaining rescores the CV once per factor
+	text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+	var plain AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &plain))
+	assert.Nil(t, plain.Explanation)
+	assert.NotContains(t, plain.AnalysisSummary, "Score Explanation")
+
+	// The cached result without an explanation is not reused
+	text, err = callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "explain": true})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &analyzeResult))
+	assert.False(t, analyzeResult.Cached)
+	require.NotNil(t, analyzeResult.Explanation)
+	assert.Len(t, analyzeResult.Explanation.Components, 5)
+	require.NotNil(t, analyzeResult.Explanation.BestImprovement)
+	assert.Equal(t, "kubernetes", analyzeResult.Explanation.BestImprovement.Name)
+	assert.Negative(t, analyzeResult.Explanation.BestImprovement.Delta)
+	assert.Contains(t, analyzeResult.AnalysisSummary, "Score Explanation")
+	assert.Contains(t, analyzeResult.AnalysisSummary, "Largest improvement: add skill kubernetes")
+}
+
+func TestAnalyzeTool_Call_ScreeningRules(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cv := "# Olga Ivanova\nLocation: Belgrade, Serbia\n\n## Summary\nGo developer with 3 years of experience.\n\n## Languages\n- English: C1\n"
+	jd := "# Go Developer\n\nWe need Go and Kubernetes.\n"
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(cv), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte(jd), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm)
+	text, err := callTool(t, tool.Call, map[string]interface{}{
+		"cv_uri":      cvURI,
+		"jd_uri":      jdURI,
+		"rules":       "required_skills: [Go]\nmin_years: 5\nlanguages:\n  English: B2\ndegree: bachelor\n",
+		"rule_status": []string{"fail", "needs_review"},
+	})
+	require.NoError(t, err)
+
+	var analyzeResult AnalyzeResult
+	require.NoError(t, json.Unmarshal([]byte(text), &analyzeResult))
+	assert.Equal(t, "fail", analyzeResult.ScreeningStatus)
+	require.Len(t, analyzeResult.Rules, 2)
+	assert.Equal(t, "years >= 5", analyzeResult.Rules[0].Rule)
+	assert.Equal(t, "fail", analyzeResult.Rules[0].Status)
+	assert.Equal(t, "needs_review", analyzeResult.Rules[1].Status)
+	assert.Contains(t, analyzeResult.AnalysisSummary, "Screening Rules (FAIL)")
+
+	_, err = callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "rules": "salary >= 100"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "rules", validationErr.Field)
+}
+
+func TestAnalyzeTool_Call_Cache(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\nGo developer with Docker.\n"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Go Developer\n\nWe need Go and Kubernetes.\n"), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewAnalyzeTool(sm).WithAnalysisCache(true)
+	analyze := func(args map[string]interface{}) AnalyzeResult {
+		text, err := callTool(t, tool.Call, args)
+		require.NoError(t, err)
+		var result AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		return result
+	}
+
+	first := analyze(map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	assert.False(t, first.Cached)
+
+	second := analyze(map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	assert.True(t, second.Cached)
+	second.Cached = false
+	assert.Equal(t, first, second)
+
+	// Different options are analyzed separately
+	blind := analyze(map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "blind": true})
+	assert.False(t, blind.Cached)
+
+	// Settings that change scoring change the key
+	tool.WithRecencyHalfLife(1)
+	changed := analyze(map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+	assert.False(t, changed.Cached)
+}
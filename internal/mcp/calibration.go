@@ -0,0 +1,107 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+)
+
+// CalibrationRecord is the stored score distribution of one calibration group. Scores of anonymized
+// CVs, or from another scoring profile or engine fingerprint (version, dictionary, weights, settings),
+// are not comparable, so each combination has its own distribution.
+type CalibrationRecord struct {
+	Scope         string                  `json:"scope"`
+	Group         string                  `json:"group"`
+	Profile       string                  `json:"profile,omitempty"`
+	Blind         bool                    `json:"blind,omitempty"`
+	Fingerprint   string                  `json:"fingerprint"`
+	EngineVersion string                  `json:"engine_version"`
+	Histogram     analysis.ScoreHistogram `json:"histogram"`
+}
+
+// calibrate records an analysis score in the distributions of its JD and, when given, its JD family,
+// and returns where the score falls in each one that has enough applicants
+func (t *AnalyzeTool) calibrate(ctx context.Context, engine *analysis.AnalysisEngine, args AnalysisInputs, blind bool, score int) []analysis.ScoreCalibration {
+	t.calibrationMu.Lock()
+	defer t.calibrationMu.Unlock()
+
+	groups := []struct {
+		scope, group, applicant string
+	}{
+		{analysis.CalibrationScopeJD, args.JdURI, args.CvURI},
+	}
+	if family := normalizeFamily(args.Family); family != "" {
+		// A CV applying to several jobs of the family counts once per job
+		groups = append(groups, struct{ scope, group, applicant string }{analysis.CalibrationScopeFamily, family, args.CvURI + "|" + args.JdURI})
+	}
+
+	fingerprint := engine.Fingerprint()
+	var calibrations []analysis.ScoreCalibration
+	for _, g := range groups {
+		record, err := t.updateCalibration(g.scope, g.group, args.Profile, blind, fingerprint, g.applicant, score)
+		if err != nil {
+			t.logger.WarnContext(ctx, "failed to update score calibration", "error", err, "scope", g.scope, "group", g.group)
+			continue
+		}
+		if calibration, ok := record.Histogram.Calibrate(score); ok {
+			calibration.Scope = g.scope
+			calibration.Group = g.group
+			calibrations = append(calibrations, calibration)
+		}
+	}
+	return calibrations
+}
+
+// updateCalibration adds an applicant's score to a stored distribution and saves it
+func (t *AnalyzeTool) updateCalibration(scope, group, profile string, blind bool, fingerprint, applicant string, score int) (CalibrationRecord, error) {
+	id := storage.CalibrationID(scope, fmt.Sprintf("%s|%s|%t|%s", group, profile, blind, fingerprint))
+	record := CalibrationRecord{
+		Scope:         scope,
+		Group:         group,
+		Profile:       profile,
+		Blind:         blind,
+		Fingerprint:   fingerprint,
+		EngineVersion: analysis.EngineVersion,
+	}
+
+	data, err := t.storageManager.ReadCalibration(id)
+	if err != nil {
+		return record, err
+	}
+	if data != nil {
+		var stored CalibrationRecord
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return record, fmt.Errorf("decode calibration %s: %w", id, err)
+		}
+		record.Histogram = stored.Histogram
+	}
+
+	record.Histogram.Record(applicant, score)
+	data, err = json.Marshal(record)
+	if err != nil {
+		return record, err
+	}
+	return record, t.storageManager.SaveCalibration(id, data)
+}
+
+// calibrationSummary describes calibrated scores, e.g. "Top 8% of 25 applicants to this role"
+func calibrationSummary(calibrations []analysis.ScoreCalibration) string {
+	if len(calibrations) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\nCalibration:\n")
+	for _, c := range calibrations {
+		target := "this role"
+		if c.Scope == analysis.CalibrationScopeFamily {
+			target = fmt.Sprintf("%s roles", c.Group)
+		}
+		sb.WriteString(fmt.Sprintf("  Top %g%% of %d applicants to %s (percentile %.0f)\n", c.TopPercent, c.Applicants, target, c.Percentile))
+	}
+	return sb.String()
+}
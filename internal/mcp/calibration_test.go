@@ -0,0 +1,89 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeTool_Calibration(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	tool := NewAnalyzeTool(sm).WithCalibration(true)
+
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Backend Engineer\n\n## Requirements\n- PostgreSQL\n- Kubernetes\n- Kafka\n- Docker\n"), "jd.md")
+	require.NoError(t, err)
+
+	analyze := func(args map[string]interface{}) AnalyzeResult {
+		text, err := callTool(t, tool.Call, args)
+		require.NoError(t, err)
+		var result AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		return result
+	}
+	var cvURIs []string
+	for i, cv := range []string{"Excel", "PostgreSQL", "PostgreSQL, Docker", "PostgreSQL, Docker, Kafka", "PostgreSQL, Kubernetes, Kafka, Docker"} {
+		cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(fmt.Sprintf("# Candidate %d\n\n## Skills\n%s\n", i, cv)), fmt.Sprintf("cv%d.md", i))
+		require.NoError(t, err)
+		cvURIs = append(cvURIs, cvURI)
+	}
+
+	for _, cvURI := range cvURIs[:4] {
+		result := analyze(map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "family": "Backend"})
+		assert.Empty(t, result.Calibration, "no percentile before %d applicants", analysis.MinCalibrationSamples)
+	}
+
+	best := cvURIs[4]
+	result := analyze(map[string]interface{}{"cv_uri": best, "jd_uri": jdURI, "family": "Backend"})
+	require.Len(t, result.Calibration, 2)
+
+	jd := result.Calibration[0]
+	assert.Equal(t, analysis.CalibrationScopeJD, jd.Scope)
+	assert.Equal(t, jdURI, jd.Group)
+	assert.Equal(t, 5, jd.Applicants)
+	assert.Equal(t, 20.0, jd.TopPercent, "the strongest CV is the top fifth")
+	assert.Equal(t, 90.0, jd.Percentile)
+
+	family := result.Calibration[1]
+	assert.Equal(t, analysis.CalibrationScopeFamily, family.Scope)
+	assert.Equal(t, "backend", family.Group)
+	assert.Equal(t, 5, family.Applicants)
+
+	assert.Contains(t, result.AnalysisSummary, "Top 20% of 5 applicants to this role")
+	assert.Contains(t, result.AnalysisSummary, "applicants to backend roles")
+
+	t.Run("analyzing again does not count twice", func(t *testing.T) {
+		again := analyze(map[string]interface{}{"cv_uri": best, "jd_uri": jdURI})
+		require.Len(t, again.Calibration, 1, "no family given")
+		assert.Equal(t, 5, again.Calibration[0].Applicants)
+		assert.Equal(t, 20.0, again.Calibration[0].TopPercent)
+	})
+
+	t.Run("blind screening and engine settings have their own distributions", func(t *testing.T) {
+		blind := analyze(map[string]interface{}{"cv_uri": best, "jd_uri": jdURI, "blind": true})
+		assert.Empty(t, blind.Calibration)
+
+		text, err := callTool(t, NewAnalyzeTool(sm).WithCalibration(true).WithRecencyHalfLife(1).Call, map[string]interface{}{"cv_uri": best, "jd_uri": jdURI})
+		require.NoError(t, err)
+		var reweighted AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &reweighted))
+		assert.Empty(t, reweighted.Calibration)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		text, err := callTool(t, NewAnalyzeTool(sm).Call, map[string]interface{}{"cv_uri": best, "jd_uri": jdURI})
+		require.NoError(t, err)
+		var plain AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &plain))
+		assert.Empty(t, plain.Calibration)
+	})
+}
@@ -0,0 +1,208 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// CompareCVVersionsTool compares two revisions of a CV against the same job description
+type CompareCVVersionsTool struct {
+	storageManager *storage.StorageManager
+	engine         *analysis.AnalysisEngine
+	logger         *slog.Logger
+	blindScreening bool
+}
+
+// NewCompareCVVersionsTool creates a new compare CV versions tool
+func NewCompareCVVersionsTool(sm *storage.StorageManager) *CompareCVVersionsTool {
+	return &CompareCVVersionsTool{
+		storageManager: sm,
+		engine:         analysis.NewAnalysisEngine(),
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *CompareCVVersionsTool) WithLogger(logger *slog.Logger) *CompareCVVersionsTool {
+	t.logger = logger
+	return t
+}
+
+// WithBlindScreening anonymizes every CV before diffing, so no raw CV lines are returned
+func (t *CompareCVVersionsTool) WithBlindScreening(blind bool) *CompareCVVersionsTool {
+	t.blindScreening = blind
+	return t
+}
+
+// WithRecencyHalfLife sets the years after which an unused CV skill counts half (<= 0 disables)
+func (t *CompareCVVersionsTool) WithRecencyHalfLife(years float64) *CompareCVVersionsTool {
+	t.engine.WithRecencyHalfLife(years)
+	return t
+}
+
+// CompareCVVersionsResult is the structured compare_cv_versions output
+type CompareCVVersionsResult struct {
+	OldCvURI string `json:"old_cv_uri"`
+	NewCvURI string `json:"new_cv_uri"`
+	JdURI    string `json:"jd_uri"`
+	analysis.CVRevisionDiff
+	Summary string `json:"summary"`
+}
+
+// Call implements the MCP tool interface
+func (t *CompareCVVersionsTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		OldCvURI string `json:"old_cv_uri"`
+		NewCvURI string `json:"new_cv_uri"`
+		JdURI    string `json:"jd_uri"`
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	documents := []struct {
+		field   string
+		uri     string
+		docType storage.DocumentType
+	}{
+		{"old_cv_uri", args.OldCvURI, storage.DocumentTypeCV},
+		{"new_cv_uri", args.NewCvURI, storage.DocumentTypeCV},
+		{"jd_uri", args.JdURI, storage.DocumentTypeJD},
+	}
+	contents := make([]string, len(documents))
+	for i, doc := range documents {
+		content, errResult, err := readDocumentArg(t.storageManager, doc.field, doc.uri, doc.docType)
+		if err != nil {
+			return errResult, err
+		}
+		contents[i] = content
+	}
+	if t.blindScreening {
+		contents[0] = analysis.Anonymize(contents[0])
+		contents[1] = analysis.Anonymize(contents[1])
+	}
+
+	diff, err := t.engine.CompareRevisions(ctx, contents[0], contents[1], contents[2])
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: comparison failed: %v", err)},
+			},
+		}, err
+	}
+
+	result := CompareCVVersionsResult{
+		OldCvURI:       args.OldCvURI,
+		NewCvURI:       args.NewCvURI,
+		JdURI:          args.JdURI,
+		CVRevisionDiff: *diff,
+		Summary:        buildRevisionSummary(diff),
+	}
+
+	t.logger.DebugContext(ctx, "compared CV versions",
+		"old_cv_uri", args.OldCvURI,
+		"new_cv_uri", args.NewCvURI,
+		"jd_uri", args.JdURI,
+		"sections_changed", len(diff.Sections),
+		"unsupported_skills", len(diff.UnsupportedSkills),
+	)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
+
+// buildRevisionSummary creates a human-readable summary of a CV revision diff
+func buildRevisionSummary(diff *analysis.CVRevisionDiff) string {
+	var sb strings.Builder
+
+	if diff.OldScore != nil && diff.NewScore != nil {
+		sb.WriteString(fmt.Sprintf("Weighted Score: %d -> %d (%+d)\n", diff.OldScore.WeightedTotal, diff.NewScore.WeightedTotal, diff.ScoreDelta.WeightedTotal))
+		sb.WriteString(fmt.Sprintf("  Skill coverage: %+.1f%%\n", diff.ScoreDelta.SkillCoverage*100))
+		sb.WriteString(fmt.Sprintf("  Experience: %+.1f%%\n", diff.ScoreDelta.Experience*100))
+		sb.WriteString(fmt.Sprintf("  Term similarity: %+.1f%%\n", diff.ScoreDelta.TermSimilarity*100))
+		sb.WriteString(fmt.Sprintf("  Overall match: %+.1f%%\n", diff.ScoreDelta.OverallMatch*100))
+		sb.WriteString(fmt.Sprintf("  Proficiency: %+.1f%%\n", diff.ScoreDelta.Proficiency*100))
+	}
+
+	if len(diff.Sections) == 0 {
+		sb.WriteString("\nNo content changes.\n")
+	} else {
+		sb.WriteString("\nChanged Sections:\n")
+		for _, section := range diff.Sections {
+			sb.WriteString(fmt.Sprintf("  %s (%s): +%d / -%d lines\n", section.Section, section.Status, len(section.Added), len(section.Removed)))
+		}
+	}
+
+	if len(diff.SkillsAdded) > 0 {
+		sb.WriteString(fmt.Sprintf("\nSkills Added: %s\n", strings.Join(diff.SkillsAdded, ", ")))
+	}
+	if len(diff.SkillsRemoved) > 0 {
+		sb.WriteString(fmt.Sprintf("\nSkills Removed: %s\n", strings.Join(diff.SkillsRemoved, ", ")))
+	}
+	if len(diff.UnsupportedSkills) > 0 {
+		sb.WriteString(fmt.Sprintf("\nPossible keyword stuffing - added skills not backed by experience or projects: %s\n", strings.Join(diff.UnsupportedSkills, ", ")))
+	}
+
+	return sb.String()
+}
+
+// readDocumentArg validates a document URI argument and returns the document without frontmatter.
+// On failure it returns the tool result to send back along with the error.
+func readDocumentArg(sm *storage.StorageManager, field, uri string, docType storage.DocumentType) (string, *mcp.CallToolResult, error) {
+	if uri == "" {
+		return "", &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: '%s' parameter is required", field)},
+			},
+		}, &ValidationError{Field: field, Reason: "required parameter missing"}
+	}
+	parsedType, _, err := storage.ParseURI(uri)
+	if err != nil || parsedType != docType {
+		return "", &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: %s - invalid URI format (must be %s://), details: %v", field, docType, err)},
+			},
+		}, &ValidationError{Field: field, Value: uri, Reason: fmt.Sprintf("must be %s:// format", docType)}
+	}
+	if !sm.DocumentExists(uri) {
+		return "", &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: document not found: %s", uri)},
+			},
+		}, &ValidationError{Field: field, Value: uri, Reason: "document not found"}
+	}
+
+	content, err := sm.ReadDocument(uri)
+	if err != nil {
+		return "", &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to read %s: %v", uri, err)},
+			},
+		}, err
+	}
+	return stripFrontmatter(string(content)), nil, nil
+}
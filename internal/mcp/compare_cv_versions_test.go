@@ -0,0 +1,86 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareCVVersionsTool_Call(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	oldCV, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Experience\n### Developer - Acme\n2020 - Present\n- Built APIs in Go\n\n## Skills\nGo\n"), "cv-v1.md")
+	require.NoError(t, err)
+	newCV, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Experience\n### Developer - Acme\n2020 - Present\n- Built APIs in Go\n\n## Skills\nGo, Kubernetes, Terraform\n"), "cv-v2.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Platform Engineer\n\nWe need Go, Kubernetes and Terraform.\n"), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewCompareCVVersionsTool(sm)
+
+	t.Run("reports diff and score delta", func(t *testing.T) {
+		text, err := callTool(t, tool.Call, map[string]interface{}{"old_cv_uri": oldCV, "new_cv_uri": newCV, "jd_uri": jdURI})
+		require.NoError(t, err)
+
+		var result CompareCVVersionsResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		assert.Equal(t, oldCV, result.OldCvURI)
+		assert.ElementsMatch(t, []string{"kubernetes", "terraform"}, result.SkillsAdded)
+		assert.Empty(t, result.SkillsRemoved)
+		assert.ElementsMatch(t, []string{"kubernetes", "terraform"}, result.UnsupportedSkills)
+		require.Len(t, result.Sections, 1)
+		assert.Equal(t, analysis.SectionDiff{
+			Section: "skills",
+			Status:  analysis.SectionChanged,
+			Added:   []string{"Go, Kubernetes, Terraform"},
+			Removed: []string{"Go"},
+		}, result.Sections[0])
+		require.NotNil(t, result.OldScore)
+		require.NotNil(t, result.NewScore)
+		assert.Greater(t, result.ScoreDelta.SkillCoverage, 0.0)
+		assert.Contains(t, result.Summary, "Possible keyword stuffing")
+	})
+
+	t.Run("blind screening masks CV lines", func(t *testing.T) {
+		oldBlind, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Summary\nShe builds services.\n"), "blind-v1.md")
+		require.NoError(t, err)
+		newBlind, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Summary\nJane Doe builds Go services. She is married.\n"), "blind-v2.md")
+		require.NoError(t, err)
+
+		blindTool := NewCompareCVVersionsTool(sm).WithBlindScreening(true)
+		text, err := callTool(t, blindTool.Call, map[string]interface{}{"old_cv_uri": oldBlind, "new_cv_uri": newBlind, "jd_uri": jdURI})
+		require.NoError(t, err)
+
+		var result CompareCVVersionsResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		require.NotEmpty(t, result.Sections)
+		assert.NotContains(t, text, "Jane")
+		assert.NotContains(t, text, "She ")
+		assert.NotContains(t, text, "married")
+		assert.Contains(t, text, "[CANDIDATE]")
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		_, err := callTool(t, tool.Call, map[string]interface{}{"old_cv_uri": oldCV, "jd_uri": jdURI})
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "new_cv_uri", validationErr.Field)
+
+		_, err = callTool(t, tool.Call, map[string]interface{}{"old_cv_uri": oldCV, "new_cv_uri": jdURI, "jd_uri": jdURI})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "new_cv_uri", validationErr.Field)
+
+		_, err = callTool(t, tool.Call, map[string]interface{}{"old_cv_uri": "cv://missing", "new_cv_uri": newCV, "jd_uri": jdURI})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "document not found", validationErr.Reason)
+	})
+}
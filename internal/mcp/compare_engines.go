@@ -0,0 +1,297 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Defaults for compare_engines
+const (
+	defaultShadowK      = 3
+	defaultShadowMovers = 10
+)
+
+// CompareEnginesTool re-scores stored CV/JD pairs with the current and a candidate engine configuration
+type CompareEnginesTool struct {
+	storageManager *storage.StorageManager
+	engine         *analysis.AnalysisEngine
+	logger         *slog.Logger
+}
+
+// NewCompareEnginesTool creates a new compare engines tool
+func NewCompareEnginesTool(sm *storage.StorageManager) *CompareEnginesTool {
+	return &CompareEnginesTool{
+		storageManager: sm,
+		engine:         analysis.NewAnalysisEngine(),
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *CompareEnginesTool) WithLogger(logger *slog.Logger) *CompareEnginesTool {
+	t.logger = logger
+	return t
+}
+
+// WithRecencyHalfLife sets the years after which an unused CV skill counts half (<= 0 disables)
+func (t *CompareEnginesTool) WithRecencyHalfLife(years float64) *CompareEnginesTool {
+	t.engine.WithRecencyHalfLife(years)
+	return t
+}
+
+// WithSalaryConfig sets the exchange rates and net/gross ratio used for salary matching
+func (t *CompareEnginesTool) WithSalaryConfig(cfg analysis.SalaryConfig) *CompareEnginesTool {
+	t.engine.WithSalaryConfig(cfg)
+	return t
+}
+
+// EngineConfig describes one side of an engine comparison
+type EngineConfig struct {
+	Profile         string                  `json:"profile,omitempty"` // Scoring profile, empty for the server's weights
+	Weights         analysis.ScoringWeights `json:"weights"`
+	RecencyHalfLife float64                 `json:"recency_half_life"`
+	Fingerprint     string                  `json:"fingerprint"`
+}
+
+// CompareEnginesResult is the structured compare_engines output
+type CompareEnginesResult struct {
+	BaselineConfig  EngineConfig `json:"baseline_config"`
+	CandidateConfig EngineConfig `json:"candidate_config"`
+	analysis.ShadowReport
+	Skipped int    `json:"skipped"` // Stored pairs whose documents have expired
+	Summary string `json:"summary"`
+}
+
+// Call implements the MCP tool interface
+func (t *CompareEnginesTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		BaselineProfile          string   `json:"baseline_profile"`
+		CandidateProfile         string   `json:"candidate_profile"`
+		CandidateRecencyHalfLife *float64 `json:"candidate_recency_half_life"`
+		Limit                    int      `json:"limit"` // Optional: at most this many pairs, most recently analyzed first
+		K                        int      `json:"k"`
+		Top                      int      `json:"top"`
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if args.CandidateProfile == "" && args.CandidateRecencyHalfLife == nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Error: give 'candidate_profile' or 'candidate_recency_half_life' to compare against"},
+			},
+		}, &ValidationError{Field: "candidate_profile", Reason: "no candidate configuration given"}
+	}
+	for field, value := range map[string]int{"limit": args.Limit, "k": args.K, "top": args.Top} {
+		if value < 0 {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("Error: '%s' must not be negative", field)},
+				},
+			}, &ValidationError{Field: field, Value: fmt.Sprint(value), Reason: "must not be negative"}
+		}
+	}
+	if args.K == 0 {
+		args.K = defaultShadowK
+	}
+	if args.Top == 0 {
+		args.Top = defaultShadowMovers
+	}
+
+	baseline, errResult, err := t.configure("baseline_profile", args.BaselineProfile, nil)
+	if err != nil {
+		return errResult, err
+	}
+	candidate, errResult, err := t.configure("candidate_profile", args.CandidateProfile, args.CandidateRecencyHalfLife)
+	if err != nil {
+		return errResult, err
+	}
+
+	pairs, skipped, err := t.storedPairs(ctx, args.Limit)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error reading stored analyses: %v", err)},
+			},
+		}, err
+	}
+	if len(pairs) == 0 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Error: no stored CV/JD pairs to compare; run analyze_cv_jd with analysis history enabled first"},
+			},
+		}, fmt.Errorf("no stored pairs")
+	}
+
+	report, err := analysis.CompareEngines(ctx, baseline, candidate, pairs, args.K, args.Top)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: comparison failed: %v", err)},
+			},
+		}, err
+	}
+
+	result := CompareEnginesResult{
+		BaselineConfig:  engineConfig(baseline, args.BaselineProfile),
+		CandidateConfig: engineConfig(candidate, args.CandidateProfile),
+		ShadowReport:    *report,
+		Skipped:         skipped,
+	}
+	result.Summary = buildShadowSummary(result)
+
+	t.logger.InfoContext(ctx, "engines compared",
+		"pairs", report.Pairs,
+		"skipped", skipped,
+		"rank_changes", report.RankChanges,
+		"mean_shift", report.MeanShift,
+		"baseline", result.BaselineConfig.Fingerprint,
+		"candidate", result.CandidateConfig.Fingerprint,
+	)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
+
+// configure returns a copy of the server engine with a scoring profile's weights and a recency
+// half-life applied, when given
+func (t *CompareEnginesTool) configure(field, profileName string, recencyHalfLife *float64) (*analysis.AnalysisEngine, *mcp.CallToolResult, error) {
+	engine := t.engine.Clone()
+	if profileName != "" {
+		profile, err := readScoringProfile(t.storageManager, profileName)
+		if err != nil {
+			return nil, &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("Error: %s - scoring profile %q not found", field, profileName)},
+				},
+			}, &ValidationError{Field: field, Value: profileName, Reason: "scoring profile not found"}
+		}
+		engine.WithWeights(profile.Weights)
+	}
+	if recencyHalfLife != nil {
+		engine.WithRecencyHalfLife(*recencyHalfLife)
+	}
+	return engine, nil, nil
+}
+
+// engineConfig describes an engine for the result
+func engineConfig(engine *analysis.AnalysisEngine, profile string) EngineConfig {
+	return EngineConfig{
+		Profile:         profile,
+		Weights:         engine.Weights().Normalize(),
+		RecencyHalfLife: engine.RecencyHalfLife(),
+		Fingerprint:     engine.Fingerprint(),
+	}
+}
+
+// storedPairs returns the distinct CV/JD pairs of the analysis history, most recent first, whose
+// documents are still stored, and how many pairs were skipped because a document has expired
+func (t *CompareEnginesTool) storedPairs(ctx context.Context, limit int) ([]analysis.ShadowPair, int, error) {
+	uris, err := t.storageManager.ListAnalyses()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	contents := make(map[string]string)
+	read := func(uri string) (string, bool) {
+		if content, ok := contents[uri]; ok {
+			return content, true
+		}
+		if !t.storageManager.DocumentExists(uri) {
+			return "", false
+		}
+		data, err := t.storageManager.ReadDocument(uri)
+		if err != nil {
+			t.logger.DebugContext(ctx, "skipping unreadable document", "uri", uri, "error", err)
+			return "", false
+		}
+		contents[uri] = stripFrontmatter(string(data))
+		return contents[uri], true
+	}
+
+	var pairs []analysis.ShadowPair
+	seen := make(map[string]bool)
+	skipped := 0
+	for _, uri := range uris {
+		if limit > 0 && len(pairs) >= limit {
+			break
+		}
+		record, err := readAnalysisRecord(t.storageManager, uri)
+		if err != nil {
+			t.logger.DebugContext(ctx, "skipping unreadable analysis", "uri", uri, "error", err)
+			continue
+		}
+		key := record.Inputs.CvURI + "|" + record.Inputs.JdURI
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		cv, cvOK := read(record.Inputs.CvURI)
+		jd, jdOK := read(record.Inputs.JdURI)
+		if !cvOK || !jdOK {
+			skipped++
+			continue
+		}
+		pairs = append(pairs, analysis.ShadowPair{
+			CvID: record.Inputs.CvURI,
+			JdID: record.Inputs.JdURI,
+			CV:   cv,
+			JD:   jd,
+		})
+	}
+	return pairs, skipped, nil
+}
+
+// buildShadowSummary creates a human-readable summary of an engine comparison
+func buildShadowSummary(result CompareEnginesResult) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Engine comparison over %d pairs (%d JDs)", result.Pairs, result.JDs))
+	if result.Skipped > 0 {
+		sb.WriteString(fmt.Sprintf(", %d skipped (documents expired)", result.Skipped))
+	}
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("  Baseline:  mean %.1f, median %d, range %d-%d\n",
+		result.Baseline.Mean, result.Baseline.Median, result.Baseline.Min, result.Baseline.Max))
+	sb.WriteString(fmt.Sprintf("  Candidate: mean %.1f, median %d, range %d-%d\n",
+		result.Candidate.Mean, result.Candidate.Median, result.Candidate.Min, result.Candidate.Max))
+	sb.WriteString(fmt.Sprintf("  Mean shift: %+.1f; %d pairs changed score, largest by %d\n",
+		result.MeanShift, result.ChangedPairs, result.MaxMovement))
+	sb.WriteString(fmt.Sprintf("  Rank changes: %d pairs, %d entering or leaving a JD's top %d; rank correlation %.2f\n",
+		result.RankChanges, result.TopKChanges, result.K, result.Spearman))
+
+	if len(result.Movers) > 0 {
+		sb.WriteString("\nLargest movements:\n")
+		for _, m := range result.Movers {
+			sb.WriteString(fmt.Sprintf("  %s vs %s: %d -> %d (%+d), rank %d -> %d\n",
+				m.CvID, m.JdID, m.BaselineScore, m.CandidateScore, m.ScoreDelta, m.BaselineRank, m.CandidateRank))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,100 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareEnginesTool(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	tool := NewCompareEnginesTool(sm)
+
+	t.Run("no stored pairs", func(t *testing.T) {
+		_, err := callTool(t, tool.Call, map[string]interface{}{"candidate_recency_half_life": 0})
+		assert.Error(t, err)
+	})
+
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Backend Engineer\n\n## Requirements\n- PostgreSQL\n- Kubernetes\n- Docker\n"), "jd.md")
+	require.NoError(t, err)
+	analyze := NewAnalyzeTool(sm).WithHistory(true)
+	cvs := []string{
+		"# Ann\n\n## Skills\nPostgreSQL, Kubernetes, Docker\n",
+		"# Bob\n\n## Skills\nPostgreSQL\n",
+		"# Cid\n\n## Skills\nExcel\n",
+	}
+	for i, cv := range cvs {
+		cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(cv), fmt.Sprintf("cv%d.md", i))
+		require.NoError(t, err)
+		_, err = callTool(t, analyze.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+		require.NoError(t, err)
+	}
+	// A repeated analysis of the same pair is compared once
+	analyses, err := sm.ListAnalyses()
+	require.NoError(t, err)
+	record, err := readAnalysisRecord(sm, analyses[0])
+	require.NoError(t, err)
+	_, err = callTool(t, analyze.Call, map[string]interface{}{"cv_uri": record.Inputs.CvURI, "jd_uri": jdURI})
+	require.NoError(t, err)
+
+	profile, err := json.Marshal(ScoringProfile{
+		Name:    "terms",
+		Weights: analysis.ScoringWeights{TermSimilarity: 0.5, OverallMatch: 0.5},
+	})
+	require.NoError(t, err)
+	require.NoError(t, sm.SaveProfile("terms", profile))
+
+	t.Run("compares against a profile", func(t *testing.T) {
+		text, err := callTool(t, tool.Call, map[string]interface{}{"candidate_profile": "terms", "k": 1})
+		require.NoError(t, err)
+
+		var result CompareEnginesResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		assert.Equal(t, 3, result.Pairs)
+		assert.Equal(t, 1, result.JDs)
+		assert.Equal(t, 1, result.K)
+		assert.Equal(t, "terms", result.CandidateConfig.Profile)
+		assert.Equal(t, analysis.NewDefaultWeights(), result.BaselineConfig.Weights)
+		assert.NotEqual(t, result.BaselineConfig.Fingerprint, result.CandidateConfig.Fingerprint)
+		assert.NotEmpty(t, result.Movers)
+		assert.Contains(t, result.Summary, "Engine comparison over 3 pairs")
+	})
+
+	t.Run("identical configuration changes nothing", func(t *testing.T) {
+		text, err := callTool(t, tool.Call, map[string]interface{}{"candidate_recency_half_life": analysis.DefaultRecencyHalfLife, "limit": 2})
+		require.NoError(t, err)
+
+		var result CompareEnginesResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		assert.Equal(t, 2, result.Pairs)
+		assert.Zero(t, result.RankChanges)
+		assert.Zero(t, result.ChangedPairs)
+		assert.Empty(t, result.Movers)
+		assert.Equal(t, result.BaselineConfig.Fingerprint, result.CandidateConfig.Fingerprint)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := callTool(t, tool.Call, map[string]interface{}{})
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "candidate_profile", validationErr.Field)
+
+		_, err = callTool(t, tool.Call, map[string]interface{}{"candidate_profile": "missing"})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "candidate_profile", validationErr.Field)
+
+		_, err = callTool(t, tool.Call, map[string]interface{}{"candidate_profile": "terms", "top": -1})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "top", validationErr.Field)
+	})
+}
@@ -1,16 +1,29 @@
 package mcp
 
 import (
+	"strings"
+
 	"github.com/ilyakaznacheev/cleanenv"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
 )
 
 // Config holds the configuration for the MCP server
 type Config struct {
-	StoragePath     string `env:"STORAGE_PATH" env-default:"./storage" env-description:"Storage directory path"`
-	StorageTTL      string `env:"STORAGE_TTL" env-default:"24h" env-description:"Default TTL for document cleanup (e.g., 24h, 1h30m)"`
-	Port            int    `env:"PORT" env-default:"8080" env-description:"HTTP server port"`
-	LogDebug        bool   `env:"DEBUG" env-default:"false" env-description:"Enable debug logging"`
-	LangExtractHost string `env:"LANGEXTRACT_HOST" env-default:"localhost:8000" env-description:"LangExtract service host and port"`
+	StoragePath        string             `env:"STORAGE_PATH" env-default:"./storage" env-description:"Storage directory path"`
+	StorageTTL         string             `env:"STORAGE_TTL" env-default:"24h" env-description:"Default TTL for document cleanup (e.g., 24h, 1h30m)"`
+	Port               int                `env:"PORT" env-default:"8080" env-description:"HTTP server port"`
+	LogDebug           bool               `env:"DEBUG" env-default:"false" env-description:"Enable debug logging"`
+	LangExtractHost    string             `env:"LANGEXTRACT_HOST" env-default:"localhost:8000" env-description:"LangExtract service host and port"`
+	BlindScreening     bool               `env:"BLIND_SCREENING" env-default:"false" env-description:"Anonymize CVs for all analyses and cv:// reads"`
+	DuplicateThreshold float64            `env:"DUPLICATE_THRESHOLD" env-default:"0.9" env-description:"Similarity (0-1) above which documents count as near-duplicates"`
+	RecencyHalfLife    float64            `env:"SKILL_RECENCY_HALF_LIFE" env-default:"5" env-description:"Years after which an unused CV skill counts half in scoring (0 disables)"`
+	AnalysisCache      bool               `env:"ANALYSIS_CACHE" env-default:"true" env-description:"Cache analysis results on disk, keyed by document IDs and engine version"`
+	AnalysisHistory    bool               `env:"ANALYSIS_HISTORY" env-default:"true" env-description:"Store every analysis as an analysis:// record"`
+	ScoreCalibration   bool               `env:"SCORE_CALIBRATION" env-default:"true" env-description:"Record scores per JD and JD family and report each score's percentile among applicants"`
+	SalaryCurrency     string             `env:"SALARY_BASE_CURRENCY" env-default:"USD" env-description:"Currency salaries are normalized to for comparison"`
+	SalaryRates        map[string]float64 `env:"SALARY_RATES" env-description:"Value of one unit of each currency in the base currency (e.g., EUR:1.08,RUB:0.011)"`
+	SalaryNetRatio     float64            `env:"SALARY_NET_RATIO" env-default:"0.87" env-description:"Take-home share of gross pay, used to compare net and gross salaries"`
 }
 
 // LoadConfig loads configuration from environment variables
@@ -51,3 +64,70 @@ func (c Config) WithLangExtractHost(host string) Config {
 	c.LangExtractHost = host
 	return c
 }
+
+// WithBlindScreening enables or disables blind screening for all CV reads
+func (c Config) WithBlindScreening(blind bool) Config {
+	c.BlindScreening = blind
+	return c
+}
+
+// WithDuplicateThreshold sets the near-duplicate similarity threshold
+func (c Config) WithDuplicateThreshold(threshold float64) Config {
+	c.DuplicateThreshold = threshold
+	return c
+}
+
+// WithRecencyHalfLife sets the skill recency half-life in years
+func (c Config) WithRecencyHalfLife(years float64) Config {
+	c.RecencyHalfLife = years
+	return c
+}
+
+// WithAnalysisCache enables or disables the on-disk analysis result cache
+func (c Config) WithAnalysisCache(enabled bool) Config {
+	c.AnalysisCache = enabled
+	return c
+}
+
+// WithAnalysisHistory enables or disables storing analyses as analysis:// records
+func (c Config) WithAnalysisHistory(enabled bool) Config {
+	c.AnalysisHistory = enabled
+	return c
+}
+
+// WithScoreCalibration enables or disables percentile calibration of analysis scores
+func (c Config) WithScoreCalibration(enabled bool) Config {
+	c.ScoreCalibration = enabled
+	return c
+}
+
+// WithSalaryCurrency sets the currency salaries are normalized to
+func (c Config) WithSalaryCurrency(currency string) Config {
+	c.SalaryCurrency = currency
+	return c
+}
+
+// WithSalaryRates sets the exchange rates into the salary base currency
+func (c Config) WithSalaryRates(rates map[string]float64) Config {
+	c.SalaryRates = rates
+	return c
+}
+
+// WithSalaryNetRatio sets the take-home share of gross pay
+func (c Config) WithSalaryNetRatio(ratio float64) Config {
+	c.SalaryNetRatio = ratio
+	return c
+}
+
+// SalaryConfig returns the salary normalization settings for the analysis engine
+func (c Config) SalaryConfig() analysis.SalaryConfig {
+	rates := make(map[string]float64, len(c.SalaryRates))
+	for currency, rate := range c.SalaryRates {
+		rates[strings.ToUpper(strings.TrimSpace(currency))] = rate
+	}
+	return analysis.SalaryConfig{
+		BaseCurrency: strings.ToUpper(c.SalaryCurrency),
+		Rates:        rates,
+		NetRatio:     c.SalaryNetRatio,
+	}
+}
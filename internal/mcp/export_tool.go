@@ -0,0 +1,121 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/converter"
+	"github.com/kfreiman/vibecheck/internal/parse"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ExportCVTool exports a stored CV in a structured interchange format
+type ExportCVTool struct {
+	storageManager *storage.StorageManager
+	logger         *slog.Logger
+	blindScreening bool
+}
+
+// NewExportCVTool creates a new export CV tool
+func NewExportCVTool(storageManager *storage.StorageManager) *ExportCVTool {
+	return &ExportCVTool{
+		storageManager: storageManager,
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *ExportCVTool) WithLogger(logger *slog.Logger) *ExportCVTool {
+	t.logger = logger
+	return t
+}
+
+// WithBlindScreening anonymizes every CV before export
+func (t *ExportCVTool) WithBlindScreening(blind bool) *ExportCVTool {
+	t.blindScreening = blind
+	return t
+}
+
+// Call implements the MCP tool interface
+func (t *ExportCVTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse arguments
+	var args struct {
+		CvURI  string `json:"cv_uri"` // URI of ingested CV (cv://[uuid])
+		Format string `json:"format"` // Optional: "json_resume" (default)
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return nil, fmt.Errorf("invalid input format: %w", err)
+	}
+
+	if args.CvURI == "" {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Error: 'cv_uri' parameter is required"},
+			},
+		}, &ValidationError{Field: "cv_uri", Reason: "required parameter missing"}
+	}
+	if args.Format == "" {
+		args.Format = string(converter.FormatJSONResume)
+	}
+	if args.Format != string(converter.FormatJSONResume) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: unsupported export format '%s'. Use 'json_resume'", args.Format)},
+			},
+		}, &ValidationError{Field: "format", Value: args.Format, Reason: "unsupported export format"}
+	}
+
+	docType, _, err := storage.ParseURI(args.CvURI)
+	if err != nil || docType != storage.DocumentTypeCV {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: cv_uri - invalid CV URI format (must be cv://), details: %v", err)},
+			},
+		}, &ValidationError{Field: "cv_uri", Value: args.CvURI, Reason: "must be cv:// format"}
+	}
+
+	if !t.storageManager.DocumentExists(args.CvURI) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: CV document not found: %s", args.CvURI)},
+			},
+		}, &ValidationError{Field: "cv_uri", Value: args.CvURI, Reason: "document not found"}
+	}
+
+	content, err := t.storageManager.ReadDocument(args.CvURI)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to read CV document: %v", err)},
+			},
+		}, err
+	}
+
+	cv := stripFrontmatter(string(content))
+	if t.blindScreening {
+		cv = analysis.Anonymize(cv)
+	}
+	resume := converter.NewJSONResumeFromCV(parse.ParseCV(cv))
+
+	jsonData, err := json.MarshalIndent(resume, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	t.logger.DebugContext(ctx, "exported CV", "cv_uri", args.CvURI, "format", args.Format)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
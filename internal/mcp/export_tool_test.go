@@ -0,0 +1,88 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/converter"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callExportCV(t *testing.T, tool *ExportCVTool, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	t.Helper()
+	argsJSON, err := json.Marshal(args)
+	require.NoError(t, err)
+	return tool.Call(context.Background(), &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Arguments: json.RawMessage(argsJSON)},
+	})
+}
+
+func TestExportCVTool_Call(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\njane@example.com\n\n## Experience\n### Engineer @ Acme (2019 - 2021)\n- Built APIs\n\n## Skills\nGo, SQL"), "cv.md")
+	require.NoError(t, err)
+
+	tool := NewExportCVTool(sm)
+
+	result, err := callExportCV(t, tool, map[string]interface{}{"cv_uri": cvURI})
+	require.NoError(t, err)
+	require.Len(t, result.Content, 1)
+
+	var resume converter.JSONResume
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &resume))
+	assert.Equal(t, converter.JSONResumeSchema, resume.Schema)
+	assert.Equal(t, "Jane Doe", resume.Basics.Name)
+	assert.Equal(t, "jane@example.com", resume.Basics.Email)
+	require.Len(t, resume.Work, 1)
+	assert.Equal(t, "Acme", resume.Work[0].Name)
+	assert.Equal(t, "2019", resume.Work[0].StartDate)
+	assert.Equal(t, "2021", resume.Work[0].EndDate)
+	assert.Len(t, resume.Skills, 2)
+
+	t.Run("BlindScreening", func(t *testing.T) {
+		blindURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Summary\nShe is married, 35 years old.\n\n## Skills\nGo, SQL"), "blind-cv.md")
+		require.NoError(t, err)
+
+		result, err := callExportCV(t, NewExportCVTool(sm).WithBlindScreening(true), map[string]interface{}{"cv_uri": blindURI})
+		require.NoError(t, err)
+		text := result.Content[0].(*mcp.TextContent).Text
+
+		var resume converter.JSONResume
+		require.NoError(t, json.Unmarshal([]byte(text), &resume))
+		assert.NotEqual(t, "Jane Doe", resume.Basics.Name)
+		assert.NotContains(t, text, "Jane")
+		assert.NotContains(t, text, "married")
+		assert.Len(t, resume.Skills, 2)
+	})
+
+	t.Run("MissingURI", func(t *testing.T) {
+		_, err := callExportCV(t, tool, map[string]interface{}{})
+		assert.Error(t, err)
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		_, err := callExportCV(t, tool, map[string]interface{}{"cv_uri": cvURI, "format": "europass"})
+		var valErr *ValidationError
+		assert.ErrorAs(t, err, &valErr)
+	})
+
+	t.Run("JDURI", func(t *testing.T) {
+		_, err := callExportCV(t, tool, map[string]interface{}{"cv_uri": "jd://abc"})
+		assert.Error(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := callExportCV(t, tool, map[string]interface{}{"cv_uri": "cv://nonexistent"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,111 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// FindDuplicatesTool clusters near-identical stored documents by SimHash similarity
+type FindDuplicatesTool struct {
+	storageManager *storage.StorageManager
+	logger         *slog.Logger
+	threshold      float64
+}
+
+// NewFindDuplicatesTool creates a new find duplicates tool
+func NewFindDuplicatesTool(storageManager *storage.StorageManager) *FindDuplicatesTool {
+	return &FindDuplicatesTool{
+		storageManager: storageManager,
+		logger:         slog.Default(),
+		threshold:      storage.DefaultDuplicateThreshold,
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *FindDuplicatesTool) WithLogger(logger *slog.Logger) *FindDuplicatesTool {
+	t.logger = logger
+	return t
+}
+
+// WithThreshold sets the default similarity threshold used when the request has none
+func (t *FindDuplicatesTool) WithThreshold(threshold float64) *FindDuplicatesTool {
+	t.threshold = threshold
+	return t
+}
+
+// FindDuplicatesResult is the structured find_duplicates output
+type FindDuplicatesResult struct {
+	Threshold float64                    `json:"threshold"`
+	Clusters  []storage.DuplicateCluster `json:"clusters"`
+}
+
+// Call implements the MCP tool interface
+func (t *FindDuplicatesTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		Threshold float64 `json:"threshold"` // Optional: similarity 0-1 (default from config)
+		Type      string  `json:"type"`      // Optional: "cv", "jd", or empty for both
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return nil, fmt.Errorf("invalid input format: %w", err)
+	}
+
+	if args.Threshold == 0 {
+		args.Threshold = t.threshold
+	}
+	if args.Threshold < 0 || args.Threshold > 1 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: threshold must be between 0 and 1, got %v", args.Threshold)},
+			},
+		}, &ValidationError{Field: "threshold", Value: fmt.Sprint(args.Threshold), Reason: "must be between 0 and 1"}
+	}
+	if args.Type != "" && args.Type != string(storage.DocumentTypeCV) && args.Type != string(storage.DocumentTypeJD) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid type '%s'. Use 'cv', 'jd', or leave empty for all documents", args.Type)},
+			},
+		}, &ValidationError{Field: "type", Value: args.Type, Reason: "must be 'cv' or 'jd'"}
+	}
+
+	clusters, err := t.storageManager.FindDuplicateClusters(args.Threshold)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error finding duplicates: %v", err)},
+			},
+		}, err
+	}
+
+	result := FindDuplicatesResult{Threshold: args.Threshold, Clusters: []storage.DuplicateCluster{}}
+	for _, cluster := range clusters {
+		if args.Type == "" || string(cluster.Type) == args.Type {
+			result.Clusters = append(result.Clusters, cluster)
+		}
+	}
+
+	t.logger.DebugContext(ctx, "found duplicate clusters",
+		"threshold", args.Threshold,
+		"clusters", len(result.Clusters),
+	)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
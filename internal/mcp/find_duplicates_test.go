@@ -0,0 +1,105 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/ingest"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const duplicateCV = `# Jane Doe - Backend Engineer
+
+Backend engineer with eight years of experience building distributed systems and payment platforms.
+
+## Experience
+- Senior Software Engineer at Acme Corp (2021 - Present): led the migration of forty services to Kubernetes
+- Software Engineer at Initech (2016 - 2020): built billing services in Java and Go
+
+## Skills
+Go, Java, PostgreSQL, Kafka, Kubernetes
+`
+
+func callTool(t *testing.T, call func(context.Context, *mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]interface{}) (string, error) {
+	t.Helper()
+	argsJSON, err := json.Marshal(args)
+	require.NoError(t, err)
+	result, err := call(context.Background(), &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Arguments: json.RawMessage(argsJSON)},
+	})
+	if result == nil || len(result.Content) == 0 {
+		return "", err
+	}
+	return result.Content[0].(*mcp.TextContent).Text, err
+}
+
+func TestFindDuplicatesTool_Call(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	original, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(duplicateCV), "cv.md")
+	require.NoError(t, err)
+	copyURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(strings.ReplaceAll(duplicateCV, "\n", "\n\n")), "cv.pdf")
+	require.NoError(t, err)
+	_, err = sm.SaveDocument(storage.DocumentTypeCV, []byte("# John Smith\n\nFrontend developer working with React and TypeScript."), "other.md")
+	require.NoError(t, err)
+
+	tool := NewFindDuplicatesTool(sm)
+
+	text, err := callTool(t, tool.Call, map[string]interface{}{})
+	require.NoError(t, err)
+
+	var result FindDuplicatesResult
+	require.NoError(t, json.Unmarshal([]byte(text), &result))
+	assert.Equal(t, storage.DefaultDuplicateThreshold, result.Threshold)
+	require.Len(t, result.Clusters, 1)
+	assert.ElementsMatch(t, []string{original, copyURI}, result.Clusters[0].URIs)
+
+	// JD filter excludes CV clusters
+	text, err = callTool(t, tool.Call, map[string]interface{}{"type": "jd"})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(text), &result))
+	assert.Empty(t, result.Clusters)
+
+	_, err = callTool(t, tool.Call, map[string]interface{}{"threshold": 1.5})
+	assert.Error(t, err)
+	_, err = callTool(t, tool.Call, map[string]interface{}{"type": "resume"})
+	assert.Error(t, err)
+}
+
+func TestIngestDocumentTool_NearDuplicateWarning(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	tool := NewIngestDocumentTool(ingest.NewIngestor(sm, nil))
+
+	dir := t.TempDir()
+	first := filepath.Join(dir, "cv.md")
+	require.NoError(t, os.WriteFile(first, []byte(duplicateCV), 0600))
+	second := filepath.Join(dir, "cv_reexported.md")
+	require.NoError(t, os.WriteFile(second, []byte(strings.ReplaceAll(duplicateCV, "\n", "\r\n")), 0600))
+
+	text, err := callTool(t, tool.Call, map[string]interface{}{"path": first, "type": "cv"})
+	require.NoError(t, err)
+	assert.NotContains(t, text, "near-duplicate")
+
+	// A re-exported copy gets a new URI but is reported as a near-duplicate
+	text, err = callTool(t, tool.Call, map[string]interface{}{"path": second, "type": "cv"})
+	require.NoError(t, err)
+	assert.Contains(t, text, "Warning: this document is a near-duplicate of:")
+	assert.Contains(t, text, "similarity 100%")
+}
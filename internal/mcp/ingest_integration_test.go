@@ -263,5 +263,5 @@ func TestMCPServerIngest(t *testing.T) {
 
 	// Verify resource templates exist
 	templates := storageHandler.ListResourceTemplates()
-	assert.Len(t, templates, 2, "Should have 2 templates (cv:// and jd://)")
+	assert.Len(t, templates, 5, "Should have 5 templates (cv://, cv://{id}/blind, cv://{id}/structured, jd:// and jd://{id}/structured)")
 }
@@ -83,14 +83,34 @@ Note: Some features may be limited due to temporary issues. The core functionali
 		}, err
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: fmt.Sprintf(`Document ingested successfully!
+	text := fmt.Sprintf(`Document ingested successfully!
 
 URI: %s
 Original filename: %s
 
-Use this URI in the analyze_fit prompt to analyze the document.`, uri, args.Type)},
+Use this URI in the analyze_fit prompt to analyze the document.`, uri, args.Type)
+
+	// Warn about near-duplicates: re-exported or lightly edited copies get a new content hash
+	if detector, ok := t.ingestor.(ingest.DuplicateDetector); ok {
+		duplicates, err := detector.NearDuplicates(uri)
+		if err != nil {
+			t.logger.DebugContext(ctx, "near-duplicate check failed", "uri", uri, "error", err)
+		} else if len(duplicates) > 0 {
+			t.logger.WarnContext(ctx, "ingested document is a near-duplicate of a stored document",
+				"uri", uri,
+				"duplicate_of", duplicates[0].URI,
+				"similarity", duplicates[0].Similarity,
+			)
+			text += "\n\nWarning: this document is a near-duplicate of:"
+			for _, dup := range duplicates {
+				text += fmt.Sprintf("\n- %s (similarity %.0f%%)", dup.URI, dup.Similarity*100)
+			}
+		}
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: text},
 		},
 	}, nil
 }
@@ -0,0 +1,315 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Hiring outcome labels
+const (
+	OutcomeHired    = "hired"
+	OutcomeRejected = "rejected"
+)
+
+// OutcomeRecord is a labeled hiring decision with the score components of the CV/JD pair.
+// Components are stored at recording time, so training does not depend on the documents,
+// which expire with the storage TTL.
+type OutcomeRecord struct {
+	ID            string                  `json:"id"`
+	CvURI         string                  `json:"cv_uri"`
+	JdURI         string                  `json:"jd_uri"`
+	Family        string                  `json:"family,omitempty"` // JD family, e.g. "backend"
+	Outcome       string                  `json:"outcome"`          // OutcomeHired or OutcomeRejected
+	Components    analysis.ScoreBreakdown `json:"components"`
+	EngineVersion string                  `json:"engine_version"`
+	RecordedAt    time.Time               `json:"recorded_at"`
+}
+
+// ScoringProfile is a named set of scoring weights trained from outcomes
+type ScoringProfile struct {
+	Name          string                  `json:"name"`
+	Family        string                  `json:"family,omitempty"` // Empty when trained on every family
+	Weights       analysis.ScoringWeights `json:"weights"`
+	Training      analysis.TrainingResult `json:"training"`
+	EngineVersion string                  `json:"engine_version"`
+	TrainedAt     time.Time               `json:"trained_at"`
+}
+
+// readScoringProfile reads and decodes a stored scoring profile
+func readScoringProfile(sm *storage.StorageManager, name string) (ScoringProfile, error) {
+	var profile ScoringProfile
+	data, err := sm.ReadProfile(name)
+	if err != nil {
+		return profile, err
+	}
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return profile, fmt.Errorf("decode profile %s: %w", name, err)
+	}
+	return profile, nil
+}
+
+// normalizeFamily lowercases and trims a JD family name
+func normalizeFamily(family string) string {
+	return strings.ToLower(strings.TrimSpace(family))
+}
+
+// RecordOutcomeTool stores labeled hiring outcomes for weight training
+type RecordOutcomeTool struct {
+	storageManager *storage.StorageManager
+	engine         *analysis.AnalysisEngine
+	logger         *slog.Logger
+	blindScreening bool
+}
+
+// NewRecordOutcomeTool creates a new record outcome tool
+func NewRecordOutcomeTool(sm *storage.StorageManager) *RecordOutcomeTool {
+	return &RecordOutcomeTool{
+		storageManager: sm,
+		engine:         analysis.NewAnalysisEngine(),
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *RecordOutcomeTool) WithLogger(logger *slog.Logger) *RecordOutcomeTool {
+	t.logger = logger
+	return t
+}
+
+// WithBlindScreening anonymizes every CV before scoring, so outcomes train on what analyses score
+func (t *RecordOutcomeTool) WithBlindScreening(blind bool) *RecordOutcomeTool {
+	t.blindScreening = blind
+	return t
+}
+
+// WithRecencyHalfLife sets the years after which an unused CV skill counts half (<= 0 disables)
+func (t *RecordOutcomeTool) WithRecencyHalfLife(years float64) *RecordOutcomeTool {
+	t.engine.WithRecencyHalfLife(years)
+	return t
+}
+
+// Call implements the MCP tool interface
+func (t *RecordOutcomeTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		CvURI   string `json:"cv_uri"`
+		JdURI   string `json:"jd_uri"`
+		Outcome string `json:"outcome"`
+		Family  string `json:"family"`
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	args.Outcome = strings.ToLower(strings.TrimSpace(args.Outcome))
+	if args.Outcome != OutcomeHired && args.Outcome != OutcomeRejected {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: outcome - must be %q or %q", OutcomeHired, OutcomeRejected)},
+			},
+		}, &ValidationError{Field: "outcome", Value: args.Outcome, Reason: "must be hired or rejected"}
+	}
+
+	cvContent, errResult, err := readDocumentArg(t.storageManager, "cv_uri", args.CvURI, storage.DocumentTypeCV)
+	if err != nil {
+		return errResult, err
+	}
+	jdContent, errResult, err := readDocumentArg(t.storageManager, "jd_uri", args.JdURI, storage.DocumentTypeJD)
+	if err != nil {
+		return errResult, err
+	}
+	if t.blindScreening {
+		cvContent = analysis.Anonymize(cvContent)
+	}
+
+	result, err := t.engine.Analyze(ctx, cvContent, jdContent)
+	if err != nil || result.ScoringBreakdown == nil {
+		if err == nil {
+			err = fmt.Errorf("no score breakdown")
+		}
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: analysis failed: %v", err)},
+			},
+		}, err
+	}
+
+	record := OutcomeRecord{
+		ID:            storage.OutcomeID(args.CvURI, args.JdURI),
+		CvURI:         args.CvURI,
+		JdURI:         args.JdURI,
+		Family:        normalizeFamily(args.Family),
+		Outcome:       args.Outcome,
+		Components:    *result.ScoringBreakdown,
+		EngineVersion: analysis.EngineVersion,
+		RecordedAt:    time.Now().UTC(),
+	}
+
+	jsonData, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+	if err := t.storageManager.SaveOutcome(record.ID, jsonData); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to save outcome: %v", err)},
+			},
+		}, err
+	}
+
+	t.logger.InfoContext(ctx, "outcome recorded",
+		"cv_uri", args.CvURI,
+		"jd_uri", args.JdURI,
+		"outcome", record.Outcome,
+		"family", record.Family,
+	)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
+
+// TrainWeightsTool fits scoring weights to recorded outcomes and saves them as a profile
+type TrainWeightsTool struct {
+	storageManager *storage.StorageManager
+	logger         *slog.Logger
+}
+
+// NewTrainWeightsTool creates a new train weights tool
+func NewTrainWeightsTool(sm *storage.StorageManager) *TrainWeightsTool {
+	return &TrainWeightsTool{
+		storageManager: sm,
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *TrainWeightsTool) WithLogger(logger *slog.Logger) *TrainWeightsTool {
+	t.logger = logger
+	return t
+}
+
+// Call implements the MCP tool interface
+func (t *TrainWeightsTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		Profile string `json:"profile"`
+		Family  string `json:"family"` // Optional: only outcomes of this JD family
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if err := storage.ValidateProfileName(args.Profile); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: profile - %v", err)},
+			},
+		}, &ValidationError{Field: "profile", Value: args.Profile, Reason: err.Error()}
+	}
+	family := normalizeFamily(args.Family)
+
+	records, err := t.storageManager.ReadOutcomes()
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error reading outcomes: %v", err)},
+			},
+		}, err
+	}
+
+	// Components from another engine version are not comparable, so only current outcomes train
+	var samples []analysis.TrainingSample
+	stale := 0
+	for _, data := range records {
+		var record OutcomeRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			t.logger.DebugContext(ctx, "skipping unreadable outcome", "error", err)
+			continue
+		}
+		if family != "" && record.Family != family {
+			continue
+		}
+		if record.EngineVersion != analysis.EngineVersion {
+			stale++
+			continue
+		}
+		samples = append(samples, analysis.TrainingSample{
+			Components: record.Components,
+			Hired:      record.Outcome == OutcomeHired,
+		})
+	}
+
+	training, err := analysis.TrainWeights(samples)
+	if err != nil {
+		text := fmt.Sprintf("Error: training failed: %v", err)
+		if stale > 0 {
+			text += fmt.Sprintf(" (%d outcomes recorded with an older engine version were skipped; record them again)", stale)
+		}
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: text},
+			},
+		}, err
+	}
+
+	profile := ScoringProfile{
+		Name:          args.Profile,
+		Family:        family,
+		Weights:       training.Weights,
+		Training:      *training,
+		EngineVersion: analysis.EngineVersion,
+		TrainedAt:     time.Now().UTC(),
+	}
+	jsonData, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+	if err := t.storageManager.SaveProfile(profile.Name, jsonData); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to save profile: %v", err)},
+			},
+		}, err
+	}
+
+	t.logger.InfoContext(ctx, "scoring profile trained",
+		"profile", profile.Name,
+		"family", profile.Family,
+		"samples", len(samples),
+		"stale_outcomes", stale,
+		"validation_auc", training.Validation.AUC,
+		"baseline_auc", training.Validation.BaselineAUC,
+	)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
@@ -0,0 +1,135 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutcomeTraining(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Backend Engineer\n\n## Requirements\n- PostgreSQL\n- Kubernetes\n- Kafka\n- Docker\n"), "jd.md")
+	require.NoError(t, err)
+
+	// Candidates covering more of the stack were hired
+	stacks := []string{"PostgreSQL", "Kubernetes", "Kafka", "Docker"}
+	record := NewRecordOutcomeTool(sm)
+	for i := 0; i < 20; i++ {
+		covered := i % 4
+		cv := fmt.Sprintf("# Candidate %d\n\n## Skills\nExcel", i)
+		for _, skill := range stacks[:covered] {
+			cv += ", " + skill
+		}
+		cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(cv+"\n"), fmt.Sprintf("cv%d.md", i))
+		require.NoError(t, err)
+
+		outcome := OutcomeRejected
+		if covered >= 2 {
+			outcome = OutcomeHired
+		}
+		text, err := callTool(t, record.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "outcome": outcome, "family": " Backend "})
+		require.NoError(t, err)
+
+		var stored OutcomeRecord
+		require.NoError(t, json.Unmarshal([]byte(text), &stored))
+		assert.Equal(t, "backend", stored.Family)
+		assert.Equal(t, outcome, stored.Outcome)
+	}
+
+	// Components recorded by another engine version are not comparable and must not train
+	stale, err := json.Marshal(OutcomeRecord{
+		ID:            "stale",
+		Family:        "backend",
+		Outcome:       OutcomeHired,
+		Components:    analysis.ScoreBreakdown{SkillCoverage: 0.1},
+		EngineVersion: "1",
+	})
+	require.NoError(t, err)
+	require.NoError(t, sm.SaveOutcome("stale", stale))
+
+	train := NewTrainWeightsTool(sm)
+
+	t.Run("trains and saves a profile", func(t *testing.T) {
+		text, err := callTool(t, train.Call, map[string]interface{}{"profile": "backend", "family": "backend"})
+		require.NoError(t, err)
+
+		var profile ScoringProfile
+		require.NoError(t, json.Unmarshal([]byte(text), &profile))
+		assert.Equal(t, "backend", profile.Name)
+		assert.NoError(t, profile.Weights.ValidateWeights())
+		assert.Equal(t, 16, profile.Training.Training.Samples)
+		assert.Equal(t, 4, profile.Training.Validation.Samples)
+		assert.Greater(t, profile.Weights.SkillCoverage, analysis.NewDefaultWeights().SkillCoverage)
+
+		stored, err := readScoringProfile(sm, "backend")
+		require.NoError(t, err)
+		assert.Equal(t, profile.Weights, stored.Weights)
+	})
+
+	t.Run("analyze_cv_jd scores with a profile", func(t *testing.T) {
+		cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane\n\n## Skills\nPostgreSQL, Docker\n"), "jane.md")
+		require.NoError(t, err)
+		tool := NewAnalyzeTool(sm).WithHistory(true)
+
+		text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "profile": "backend"})
+		require.NoError(t, err)
+		var result AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+
+		record, err := readAnalysisRecord(sm, result.AnalysisURI)
+		require.NoError(t, err)
+		profile, err := readScoringProfile(sm, "backend")
+		require.NoError(t, err)
+		assert.Equal(t, profile.Weights, record.Weights)
+		assert.Equal(t, "backend", record.Inputs.Profile)
+
+		_, err = callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "profile": "missing"})
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "profile", validationErr.Field)
+	})
+
+	t.Run("blind screening records the anonymized CV", func(t *testing.T) {
+		raw := "# Jane Doe\n\n## Summary\nJane Doe is a German engineer, married, born 1985. She runs PostgreSQL.\n\n## Skills\nPostgreSQL, Docker\n"
+		blindURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(raw), "blind-cv.md")
+		require.NoError(t, err)
+		maskedURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(analysis.Anonymize(raw)), "masked-cv.md")
+		require.NoError(t, err)
+
+		text, err := callTool(t, NewRecordOutcomeTool(sm).WithBlindScreening(true).Call, map[string]interface{}{"cv_uri": blindURI, "jd_uri": jdURI, "outcome": OutcomeHired})
+		require.NoError(t, err)
+		var blind OutcomeRecord
+		require.NoError(t, json.Unmarshal([]byte(text), &blind))
+
+		text, err = callTool(t, record.Call, map[string]interface{}{"cv_uri": maskedURI, "jd_uri": jdURI, "outcome": OutcomeHired})
+		require.NoError(t, err)
+		var masked OutcomeRecord
+		require.NoError(t, json.Unmarshal([]byte(text), &masked))
+		assert.Equal(t, masked.Components, blind.Components)
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		_, err := callTool(t, train.Call, map[string]interface{}{"profile": "frontend", "family": "frontend"})
+		assert.ErrorContains(t, err, "at least")
+
+		_, err = callTool(t, train.Call, map[string]interface{}{"profile": "../x"})
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "profile", validationErr.Field)
+
+		_, err = callTool(t, record.Call, map[string]interface{}{"cv_uri": "cv://x", "jd_uri": jdURI, "outcome": "maybe"})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "outcome", validationErr.Field)
+	})
+}
@@ -2,17 +2,35 @@ package mcp
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"strings"
 
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/parse"
 	"github.com/kfreiman/vibecheck/internal/storage"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// Resource views that can be appended to a document URI (e.g. cv://{id}/blind)
+const (
+	// ResourceViewBlind serves an anonymized CV for blind screening
+	ResourceViewBlind = "blind"
+	// ResourceViewStructured serves the parsed document model as JSON
+	ResourceViewStructured = "structured"
+)
+
+// supportedViews lists the resource views available for each document type
+var supportedViews = map[storage.DocumentType]map[string]bool{
+	storage.DocumentTypeCV: {"": true, ResourceViewBlind: true, ResourceViewStructured: true},
+	storage.DocumentTypeJD: {"": true, ResourceViewStructured: true},
+}
+
 // StorageResourceHandler handles cv:// and jd:// resource requests
 type StorageResourceHandler struct {
 	storageManager *storage.StorageManager
 	logger         *slog.Logger
+	blindScreening bool
 }
 
 // NewStorageResourceHandler creates a new storage resource handler
@@ -29,12 +47,34 @@ func (h *StorageResourceHandler) WithLogger(logger *slog.Logger) *StorageResourc
 	return h
 }
 
+// WithBlindScreening makes every cv:// read return the anonymized CV
+func (h *StorageResourceHandler) WithBlindScreening(blind bool) *StorageResourceHandler {
+	h.blindScreening = blind
+	return h
+}
+
+// splitResourceView splits a resource URI into the document URI and an optional view suffix
+func splitResourceView(uri string) (docURI, view string) {
+	schemeEnd := strings.Index(uri, "://")
+	if schemeEnd < 0 {
+		return uri, ""
+	}
+	rest := uri[schemeEnd+3:]
+	if slash := strings.Index(rest, "/"); slash >= 0 {
+		return uri[:schemeEnd+3+slash], rest[slash+1:]
+	}
+	return uri, ""
+}
+
 // ReadResource processes resource requests for stored documents
 func (h *StorageResourceHandler) ReadResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
 	uri := req.Params.URI
 
+	// Separate the document URI from an optional view (e.g. /blind)
+	docURI, view := splitResourceView(uri)
+
 	// Parse the URI
-	_, _, err := storage.ParseURI(uri)
+	docType, _, err := storage.ParseURI(docURI)
 	if err != nil {
 		h.logger.DebugContext(ctx, "failed to parse URI",
 			"uri", uri,
@@ -43,8 +83,13 @@ func (h *StorageResourceHandler) ReadResource(ctx context.Context, req *mcp.Read
 		return nil, mcp.ResourceNotFoundError(uri)
 	}
 
+	// Views are defined per document type (e.g. blind only applies to CVs)
+	if !supportedViews[docType][view] {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
 	// Read the document
-	content, err := h.storageManager.ReadDocument(uri)
+	content, err := h.storageManager.ReadDocument(docURI)
 	if err != nil {
 		h.logger.DebugContext(ctx, "failed to read document",
 			"uri", uri,
@@ -64,20 +109,55 @@ func (h *StorageResourceHandler) ReadResource(ctx context.Context, req *mcp.Read
 		}
 	}
 
+	// Mask bias-prone attributes for blind screening
+	blind := docType == storage.DocumentTypeCV && (h.blindScreening || view == ResourceViewBlind)
+	if blind {
+		textContent = analysis.Anonymize(textContent)
+	}
+
+	// Structured view returns the parsed document model
+	mimeType := "text/markdown"
+	if view == ResourceViewStructured {
+		structured, err := structuredDocument(docType, textContent)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to build structured document",
+				"uri", uri,
+				"error", err,
+			)
+			return nil, err
+		}
+		textContent = structured
+		mimeType = "application/json"
+	}
+
 	h.logger.DebugContext(ctx, "read document resource",
 		"uri", uri,
 		"size", len(content),
+		"view", view,
+		"blind", blind,
 	)
 
 	return &mcp.ReadResourceResult{
 		Contents: []*mcp.ResourceContents{{
 			URI:      uri,
-			MIMEType: "text/markdown",
+			MIMEType: mimeType,
 			Text:     textContent,
 		}},
 	}, nil
 }
 
+// structuredDocument parses document content into its typed model and returns it as JSON
+func structuredDocument(docType storage.DocumentType, content string) (string, error) {
+	switch docType {
+	case storage.DocumentTypeCV:
+		return parse.ParseCV(content).ToJSON()
+	case storage.DocumentTypeJD:
+		return parse.ParseJD(content).ToJSON()
+	default:
+		return "", fmt.Errorf("structured view not supported for %s documents", docType)
+	}
+}
+
 // ListResources lists all available resources
 func (h *StorageResourceHandler) ListResources() []*mcp.Resource {
 	cvUUIDs, jdUUIDs, err := h.storageManager.ListAllDocuments()
@@ -130,11 +210,29 @@ func (h *StorageResourceHandler) ListResourceTemplates() []mcp.ResourceTemplate
 			Description: "Access a stored CV document by its UUID",
 			MIMEType:    "text/markdown",
 		},
+		{
+			URITemplate: "cv://{id}/blind",
+			Name:        "Anonymized CV Document",
+			Description: "Access a stored CV with names, pronouns, age, photos, nationality, marital status and graduation years masked",
+			MIMEType:    "text/markdown",
+		},
+		{
+			URITemplate: "cv://{id}/structured",
+			Name:        "Structured CV",
+			Description: "Parsed CV model as JSON: contact, summary, experience, education, skills, languages, projects and certifications",
+			MIMEType:    "application/json",
+		},
 		{
 			URITemplate: "jd://{id}",
 			Name:        "Job Description",
 			Description: "Access a stored job description by its UUID",
 			MIMEType:    "text/markdown",
 		},
+		{
+			URITemplate: "jd://{id}/structured",
+			Name:        "Structured Job Description",
+			Description: "Parsed job description model as JSON: title, company, location, employment type, salary range, responsibilities, required and preferred qualifications and benefits",
+			MIMEType:    "application/json",
+		},
 	}
 }
@@ -0,0 +1,136 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/parse"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResourceHandler(t *testing.T) (*StorageResourceHandler, *storage.StorageManager) {
+	t.Helper()
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	return NewStorageResourceHandler(sm), sm
+}
+
+func readResourceText(t *testing.T, h *StorageResourceHandler, uri string) string {
+	t.Helper()
+	result, err := h.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: uri},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	return result.Contents[0].Text
+}
+
+func TestStorageResourceHandler_ReadResource(t *testing.T) {
+	h, sm := newTestResourceHandler(t)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\nShe builds Go services."), "cv.md")
+	require.NoError(t, err)
+
+	text := readResourceText(t, h, cvURI)
+	assert.Contains(t, text, "Jane Doe")
+	assert.NotContains(t, text, "original_filename")
+}
+
+func TestStorageResourceHandler_ReadResource_Blind(t *testing.T) {
+	h, sm := newTestResourceHandler(t)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\nShe builds Go services."), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("Go developer"), "jd.md")
+	require.NoError(t, err)
+
+	// Explicit blind view
+	text := readResourceText(t, h, cvURI+"/blind")
+	assert.NotContains(t, text, "Jane")
+	assert.Contains(t, text, "[CANDIDATE] builds Go services.")
+
+	// Blind view is only defined for CVs
+	_, err = h.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: jdURI + "/blind"},
+	})
+	assert.Error(t, err)
+
+	// Global blind screening anonymizes plain cv:// reads
+	h.WithBlindScreening(true)
+	text = readResourceText(t, h, cvURI)
+	assert.NotContains(t, text, "Jane")
+}
+
+func TestSplitResourceView(t *testing.T) {
+	tests := []struct {
+		uri    string
+		docURI string
+		view   string
+	}{
+		{"cv://abc", "cv://abc", ""},
+		{"cv://abc/blind", "cv://abc", "blind"},
+		{"jd://abc/structured", "jd://abc", "structured"},
+		{"invalid", "invalid", ""},
+	}
+
+	for _, tt := range tests {
+		docURI, view := splitResourceView(tt.uri)
+		assert.Equal(t, tt.docURI, docURI, tt.uri)
+		assert.Equal(t, tt.view, view, tt.uri)
+	}
+}
+
+func TestStorageResourceHandler_ReadResource_Structured(t *testing.T) {
+	h, sm := newTestResourceHandler(t)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Experience\n- Engineer @ Acme (2019 - 2021)\n\n## Skills\nGo, SQL"), "cv.md")
+	require.NoError(t, err)
+
+	result, err := h.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: cvURI + "/structured"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "application/json", result.Contents[0].MIMEType)
+
+	var cv parse.CV
+	require.NoError(t, json.Unmarshal([]byte(result.Contents[0].Text), &cv))
+	assert.Equal(t, "Jane Doe", cv.Contact.Name)
+	require.Len(t, cv.Experience, 1)
+	assert.Equal(t, "Acme", cv.Experience[0].Company)
+	assert.Equal(t, []string{"Go", "SQL"}, cv.Skills)
+
+	// Unknown views are not found
+	_, err = h.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: cvURI + "/unknown"},
+	})
+	assert.Error(t, err)
+}
+
+func TestStorageResourceHandler_ReadResource_StructuredJD(t *testing.T) {
+	h, sm := newTestResourceHandler(t)
+
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Go Engineer\n\n## Требования\n- Go\n- Kubernetes\n\n## Желательные навыки\n- Kafka"), "job.md")
+	require.NoError(t, err)
+
+	result, err := h.ReadResource(context.Background(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: jdURI + "/structured"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "application/json", result.Contents[0].MIMEType)
+
+	var jd parse.JobDescription
+	require.NoError(t, json.Unmarshal([]byte(result.Contents[0].Text), &jd))
+	assert.Equal(t, "Go Engineer", jd.Title)
+	assert.Equal(t, []string{"Go", "Kubernetes"}, jd.RequiredQualifications)
+	assert.Equal(t, []string{"Kafka"}, jd.PreferredQualifications)
+}
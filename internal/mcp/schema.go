@@ -24,14 +24,21 @@ Stdio transport is not supported.
 
 ### Storage Resources (cv://, jd://)
 - cv://[uuid]: Access an ingested CV document
+- cv://[uuid]/blind: Access an anonymized CV (names, pronouns, age, photos, nationality, marital status and graduation years masked)
+- cv://[uuid]/structured: Parsed CV as JSON (contact, summary, experience, education, skills, languages, projects, certifications)
 - jd://[uuid]: Access an ingested job description
+- jd://[uuid]/structured: Parsed job description as JSON (title, company, location, employment type, salary range, responsibilities, required/preferred qualifications, benefits)
+
+### Analysis Resources (analysis://)
+- analysis://[id]: A stored analyze_cv_jd run as JSON (inputs, scoring weights, engine version, timestamp, full result)
 
 ## Tools
 
 ### ingest_document
 Ingest a CV or job description into storage.
 Parameters:
-- path: File path or URL to document (PDF, MD)
+- path: File path or URL to document (PDF, MD, JSON Resume .json, Europass .xml) or raw text
+  JSON Resume and Europass XML CVs are converted to markdown on ingestion
 - type: Document type ("cv" or "jd")
 
 Example: {"path": "./resume.pdf", "type": "cv"}
@@ -75,20 +82,173 @@ Returns a prompt for generating interview questions focused on:
 - Areas needing clarification
 - Technical and behavioral question balance
 
+### find_duplicates
+Cluster near-identical stored documents (SimHash fingerprints stored at ingest).
+Parameters:
+- threshold: Optional - minimum similarity 0-1 (default: DUPLICATE_THRESHOLD or 0.9)
+- type: Optional filter - "cv", "jd", or empty for both
+
+Example: {"threshold": 0.85, "type": "cv"}
+
+Returns clusters of document URIs with their minimum pairwise similarity.
+ingest_document also warns when a new document is a near-duplicate of a stored one.
+
+### export_cv
+Export a stored CV as JSON Resume.
+Parameters:
+- cv_uri: URI of ingested CV (cv://[uuid])
+- format: Optional - "json_resume" (default)
+
+Example: {"cv_uri": "cv://550e8400-e29b..."}
+
+Returns the CV as a JSON Resume document (basics, work, education, skills, languages, projects, certificates).
+
 ### analyze_cv_jd
 Structured CV/Job Description analysis with BM25 match scoring.
 Parameters:
 - cv_uri: URI of ingested CV (cv://[uuid])
 - jd_uri: URI of ingested job description (jd://[uuid])
+- blind: Optional - anonymize the CV before scoring (default: false)
+- explain: Optional - attribute the score to components, skills and terms (default: false; rescores the CV once per skill and term, so it is much slower)
+- rules: Optional - knockout screening rules for the JD, as YAML or one expression per line:
+  skill Go, Kubernetes / years >= 5 / language English >= B2 / location in EU, Serbia / degree >= bachelor
+  Without rules, the rules stored for the JD by set_screening_rules apply
+- rule_status: Optional - only list rule results with these statuses (e.g. ["fail", "needs_review"]); screening_status still covers every rule
+- profile: Optional - score with the weights of a scoring profile trained by train_weights
+- family: Optional - JD family (e.g. "backend") to also calibrate the score against applicants to the family
 
 Example: {"cv_uri": "cv://550e8400-e29b...", "jd_uri": "jd://550e8400-e29b..."}
+Example: {"cv_uri": "cv://...", "jd_uri": "jd://...", "rules": "min_years: 5\nlanguages: {English: B2}\ndegree: bachelor"}
 
 Returns structured JSON with:
 - match_percentage: 0-100% based on BM25 scoring
 - skill_coverage: Ratio of JD terms present in CV
+- proficiency_alignment: How well claimed skill levels ("expert in", "B2") meet the levels the JD asks for
 - top_skills: Common terms with highest scores
 - missing_skills: JD terms not found in CV
+- skill_recency: Year each CV skill was last used and its recency discount
+- negated_skills: CV skills only mentioned in negated form ("no experience with Java")
+- skill_versions: Version compatibility (exact, newer, legacy) for JD skills pinned to a version
+- language_requirements: Pass/fail for each spoken language the JD requires, with CEFR levels
+- languages_met: false when a required spoken language is missing or below the required level
+- cv_constraints / jd_constraints: Location, timezone, remote/hybrid/onsite policy, relocation and visa sponsorship from each document
+- constraints: Checks of those constraints (pass, fail, needs_review) with reasons
+- constraints_met: false when a knockout constraint fails (e.g. onsite JD vs remote-only CV)
+- rules: Result of each screening rule (pass, fail, needs_review) with the reason
+- screening_status: fail if any rule fails, needs_review if any needs review, otherwise pass
+- salary: JD band and CV expectation normalized to annual gross pay in the base currency, with overlap and status (within, overlap, above, below, unknown)
+- explanation: Only with explain - score attribution tree - each component's value, weight and points, the skills and terms moving it (stop words, numbers and short fragments excluded) with their individual point deltas (measured by rescoring without a present factor or with a missing one), and the largest single improvement
+- calibration: Where the weighted score falls among applicants to the same JD (and JD family, when given): percentile and top percent (e.g. top 8% of applicants to this role), once at least 5 applicants were scored with the same blind setting, profile and engine settings
 - analysis_summary: Human-readable report
+- cached: true when the result was served from the analysis cache
+- analysis_uri: analysis://[id] record of this run, for revisiting it later
+
+### list_analyses
+List stored analyses, newest first, or rank candidates by score.
+Parameters:
+- cv_uri: Optional - only analyses of this CV
+- jd_uri: Optional - only analyses against this job description
+- screening_status: Optional - only analyses with these screening statuses (e.g. ["pass", "needs_review"])
+- rank: Optional - keep the latest analysis of each CV/JD pair and order by weighted score, best first (default: false)
+- limit: Optional - maximum number of analyses
+
+Example: {"jd_uri": "jd://550e8400-e29b..."}
+Example: {"jd_uri": "jd://...", "screening_status": ["pass"], "rank": true, "limit": 10}
+
+Returns analysis URIs with timestamps, CV/JD URIs, weighted score, match percentage, screening status, engine version and, when ranked, rank.
+
+### set_screening_rules
+Store knockout screening rules with a job description, so analyze_cv_jd screens every CV against it without passing rules.
+Parameters:
+- jd_uri: URI of ingested job description (jd://[uuid])
+- rules: Rules as YAML or one expression per line (same language as analyze_cv_jd's rules); empty removes the stored rules
+
+Example: {"jd_uri": "jd://550e8400-e29b...", "rules": "skill Go, Kubernetes\nyears >= 5"}
+
+Returns the stored rules as given and as parsed. Rules are removed with their job description on cleanup.
+
+### compare_cv_versions
+Compare two revisions of a CV against the same job description.
+Parameters:
+- old_cv_uri: URI of the earlier CV revision (cv://[uuid])
+- new_cv_uri: URI of the later CV revision (cv://[uuid])
+- jd_uri: URI of ingested job description (jd://[uuid])
+
+Example: {"old_cv_uri": "cv://550e8400-e29b...", "new_cv_uri": "cv://6ba7b810-9dad...", "jd_uri": "jd://..."}
+
+Returns:
+- sections: lines added and removed per CV section (added, removed or changed sections)
+- skills_added / skills_removed: skills only one revision mentions
+- unsupported_skills: added skills not mentioned in experience or projects (possible keyword stuffing)
+- old_score / new_score / score_delta: scoring breakdown of each revision and the change per component
+
+### suggest_cv_improvements
+Suggest concrete CV improvements for a job description, without an LLM.
+Parameters:
+- cv_uri: URI of ingested CV (cv://[uuid])
+- jd_uri: URI of ingested job description (jd://[uuid])
+
+Example: {"cv_uri": "cv://550e8400-e29b...", "jd_uri": "jd://..."}
+
+Returns simulated suggestions ordered by expected score impact, then the others, each with:
+- kind: missing_requirement (JD skill the CV does not mention), unquantified_claim (experience bullet with no numbers) or weak_section (missing summary or skills section, role without bullets)
+- target, issue and a concrete rewrite hint
+- score_impact: weighted score change measured by re-scoring a copy of the CV with the hint applied
+- simulated: false when the hint cannot be applied faithfully (unquantified claims, roles without bullets); score_impact is then 0
+
+### simulate_score
+What-if scoring: rescore a CV against a job description with hypothetical edits. Stored documents are not changed.
+Parameters:
+- cv_uri: URI of ingested CV (cv://[uuid])
+- jd_uri: URI of ingested job description (jd://[uuid])
+- edits: One edit per entry:
+  add skill Kubernetes with 2 years / remove PHP / set total experience to 6 years
+
+Example: {"cv_uri": "cv://550e8400-e29b...", "jd_uri": "jd://...", "edits": ["add skill Kubernetes with 2 years", "remove PHP"]}
+
+Returns the original and simulated weighted score and breakdown, the change per component and the JD skills still missing.
+A total experience edit sets the stated career length and caps the years credited per skill; it never adds years to a skill.
+
+### record_outcome
+Record whether a candidate was hired or rejected for a job, as training data for train_weights.
+Parameters:
+- cv_uri: URI of ingested CV (cv://[uuid])
+- jd_uri: URI of ingested job description (jd://[uuid])
+- outcome: "hired" or "rejected"
+- family: Optional - JD family the job belongs to (e.g. "backend"), to train weights per family
+
+Example: {"cv_uri": "cv://550e8400-e29b...", "jd_uri": "jd://...", "outcome": "hired", "family": "backend"}
+
+Stores the outcome with the pair's score components, so it stays usable after the documents expire.
+Recording the same CV/JD pair again replaces its outcome.
+
+### train_weights
+Fit scoring weights to recorded outcomes (logistic regression on the score components) and save them as a named scoring profile.
+Parameters:
+- profile: Profile name (lowercase letters, digits, '-' and '_')
+- family: Optional - only use outcomes of this JD family
+
+Example: {"profile": "backend", "family": "backend"}
+
+Only outcomes recorded with the current engine version are used, since older score components are not comparable.
+Needs at least 10 outcomes with both hired and rejected candidates; every fifth outcome is held out for validation.
+Returns the profile: weights, model coefficients, and training/validation metrics (accuracy, log loss, AUC of the learned weights vs the default weights).
+Use it with analyze_cv_jd's profile parameter.
+
+### compare_engines
+Shadow-score the stored corpus: re-score every CV/JD pair in the analysis history with the current and a candidate configuration before rolling the candidate out.
+Parameters:
+- candidate_profile: Optional - scoring profile (from train_weights) the candidate scores with
+- candidate_recency_half_life: Optional - skill recency half-life in years for the candidate (0 disables)
+- baseline_profile: Optional - scoring profile for the baseline instead of the server's weights
+- limit: Optional - at most this many pairs, most recently analyzed first
+- k: Optional - top-k cutoff per JD for counting pairs entering or leaving the top (default 3)
+- top: Optional - number of largest movements to list (default 10)
+
+Example: {"candidate_profile": "backend", "limit": 200}
+
+At least one candidate setting is required. Pairs whose documents have expired are skipped.
+Returns both configurations, score distributions (mean, spread, quartiles) and their shift, rank changes within each JD, rank correlation, and the pairs with the largest movement.
 
 ## Prompts
 
@@ -107,6 +267,7 @@ Analyze fit between ingested CV and job description.
 Parameters:
 - cv_uri: URI of ingested CV (cv://[uuid])
 - jd_uri: URI of ingested job description (jd://[uuid])
+- blind: Optional - "true" to point the client at the anonymized CV (cv://[uuid]/blind)
 
 Example: {"cv_uri": "cv://550e8400-e29b...", "jd_uri": "jd://550e8400-e29b..."}
 
@@ -123,6 +284,7 @@ Returns structured analysis with:
 - VIBECHECK_STORAGE_TTL: Default TTL for cleanup (default: 24h)
 - VIBECHECK_PORT: HTTP server port (default: 8080)
 - VIBECHECK_DEBUG: Enable debug logging (default: false)
+- BLIND_SCREENING: Anonymize CVs for all analyses, comparisons, suggestions, simulations, recorded outcomes, exports and cv:// reads (default: false)
 `
 
 // ToolDefinitions contains the MCP tool definitions
@@ -135,7 +297,7 @@ var ToolDefinitions = map[string]*mcp.Tool{
 			"properties": map[string]interface{}{
 				"path": map[string]interface{}{
 					"type":        "string",
-					"description": "File path, URL, or raw markdown content to ingest",
+					"description": "File path, URL, or raw content to ingest (PDF, markdown, JSON Resume or Europass XML)",
 				},
 				"type": map[string]interface{}{
 					"type":        "string",
@@ -207,9 +369,250 @@ var ToolDefinitions = map[string]*mcp.Tool{
 			"required": []string{"cv_uri", "jd_uri"},
 		},
 	},
+	"find_duplicates": {
+		Name:        "find_duplicates",
+		Description: "Cluster near-identical stored CVs and job descriptions using SimHash fingerprints. Catches re-exported or lightly edited copies that got a new content hash.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"threshold": map[string]interface{}{
+					"type":        "number",
+					"description": "Minimum similarity (0-1) for two documents to be near-duplicates. Uses DUPLICATE_THRESHOLD (default 0.9) if not specified.",
+					"minimum":     0,
+					"maximum":     1,
+				},
+				"type": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional filter: 'cv' for CVs only, 'jd' for job descriptions only, or empty for both",
+					"enum":        []string{"cv", "jd"},
+				},
+			},
+			"required": []string{},
+		},
+	},
+	"export_cv": {
+		Name:        "export_cv",
+		Description: "Export a stored CV as JSON Resume (https://jsonresume.org/schema).",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested CV (cv://[uuid])",
+				},
+				"format": map[string]interface{}{
+					"type":        "string",
+					"description": "Export format (default: json_resume)",
+					"enum":        []string{"json_resume"},
+					"default":     "json_resume",
+				},
+			},
+			"required": []string{"cv_uri"},
+		},
+	},
+	"list_analyses": {
+		Name:        "list_analyses",
+		Description: "List stored analyze_cv_jd runs (analysis:// records), newest first, optionally for one CV or job description, filtered by screening status or ranked by weighted score",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "Only analyses of this CV (cv://[uuid])",
+				},
+				"jd_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "Only analyses against this job description (jd://[uuid])",
+				},
+				"screening_status": map[string]interface{}{
+					"type":        "array",
+					"description": "Only analyses whose screening status is one of these",
+					"items": map[string]interface{}{
+						"type": "string",
+						"enum": []string{"pass", "fail", "needs_review"},
+					},
+				},
+				"rank": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Keep the latest analysis of each CV/JD pair and order by weighted score, best first",
+					"default":     false,
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of analyses to return",
+					"minimum":     0,
+				},
+			},
+			"required": []string{},
+		},
+	},
+	"set_screening_rules": {
+		Name:        "set_screening_rules",
+		Description: "Store knockout screening rules with a job description; analyze_cv_jd applies them whenever it is called without rules",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"jd_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested job description (jd://[uuid])",
+				},
+				"rules": map[string]interface{}{
+					"type":        "string",
+					"description": "Rules as YAML (required_skills, min_years, languages, location, degree) or expressions, one per line or separated by semicolons; empty removes the stored rules",
+				},
+			},
+			"required": []string{"jd_uri", "rules"},
+		},
+	},
+	"compare_cv_versions": {
+		Name:        "compare_cv_versions",
+		Description: "Compare two revisions of a CV against a job description: text diff by section, skills added and removed, and the change in every score component. Flags added skills with no supporting experience (keyword stuffing).",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"old_cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of the earlier CV revision (cv://[uuid])",
+				},
+				"new_cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of the later CV revision (cv://[uuid])",
+				},
+				"jd_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested job description (jd://[uuid])",
+				},
+			},
+			"required": []string{"old_cv_uri", "new_cv_uri", "jd_uri"},
+		},
+	},
+	"suggest_cv_improvements": {
+		Name:        "suggest_cv_improvements",
+		Description: "Suggest deterministic CV improvements for a job description: unmentioned requirements, experience claims without quantified evidence and weak sections, each with a rewrite hint and, where the hint can be applied, the score impact measured on a simulated CV",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested CV (cv://[uuid])",
+				},
+				"jd_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested job description (jd://[uuid])",
+				},
+			},
+			"required": []string{"cv_uri", "jd_uri"},
+		},
+	},
+	"simulate_score": {
+		Name:        "simulate_score",
+		Description: "What-if scoring: recalculate the weighted score and breakdown of a CV against a job description with hypothetical edits (add a skill with years, remove a skill, set total experience), without changing stored documents",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested CV (cv://[uuid])",
+				},
+				"jd_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested job description (jd://[uuid])",
+				},
+				"edits": map[string]interface{}{
+					"type":        "array",
+					"description": "Hypothetical edits, one per entry: \"add skill Kubernetes with 2 years\", \"remove PHP\", \"set total experience to 6 years\"",
+					"items":       map[string]interface{}{"type": "string"},
+					"minItems":    1,
+				},
+			},
+			"required": []string{"cv_uri", "jd_uri", "edits"},
+		},
+	},
+	"record_outcome": {
+		Name:        "record_outcome",
+		Description: "Record a hiring outcome (hired or rejected) for a CV/JD pair, with its score components, as training data for train_weights",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"cv_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested CV (cv://[uuid])",
+				},
+				"jd_uri": map[string]interface{}{
+					"type":        "string",
+					"description": "URI of ingested job description (jd://[uuid])",
+				},
+				"outcome": map[string]interface{}{
+					"type":        "string",
+					"description": "Hiring decision",
+					"enum":        []string{"hired", "rejected"},
+				},
+				"family": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional JD family (e.g. 'backend') for training weights per family",
+				},
+			},
+			"required": []string{"cv_uri", "jd_uri", "outcome"},
+		},
+	},
+	"train_weights": {
+		Name:        "train_weights",
+		Description: "Fit scoring weights to recorded hiring outcomes with logistic regression on the score components, report validation metrics against the default weights and save the weights as a named scoring profile",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"profile": map[string]interface{}{
+					"type":        "string",
+					"description": "Name to save the scoring profile under (lowercase letters, digits, '-' and '_')",
+				},
+				"family": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional JD family: only train on outcomes recorded for it",
+				},
+			},
+			"required": []string{"profile"},
+		},
+	},
+	"compare_engines": {
+		Name:        "compare_engines",
+		Description: "Re-score the stored CV/JD pairs from the analysis history with the current and a candidate scoring configuration, and report rank changes, score distribution shifts and the pairs that move the most",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"candidate_profile": map[string]interface{}{
+					"type":        "string",
+					"description": "Scoring profile trained by train_weights for the candidate configuration",
+				},
+				"candidate_recency_half_life": map[string]interface{}{
+					"type":        "number",
+					"description": "Skill recency half-life in years for the candidate configuration (0 disables)",
+					"minimum":     0,
+				},
+				"baseline_profile": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional scoring profile for the baseline instead of the server's weights",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Optional maximum number of pairs, most recently analyzed first",
+					"minimum":     0,
+				},
+				"k": map[string]interface{}{
+					"type":        "integer",
+					"description": "Top-k cutoff per JD for counting pairs entering or leaving the top (default 3)",
+					"minimum":     0,
+				},
+				"top": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of largest movements to list (default 10)",
+					"minimum":     0,
+				},
+			},
+		},
+	},
 	"analyze_cv_jd": {
 		Name:        "analyze_cv_jd",
-		Description: "Structured CV/Job Description analysis with BM25 match scoring. Returns match percentage, skill coverage, and gap analysis.",
+		Description: "Structured CV/Job Description analysis with BM25 match scoring. Returns match percentage, skill coverage, gap analysis and, on request, an explanation of each score component.",
 		InputSchema: map[string]interface{}{
 			"type": "object",
 			"properties": map[string]interface{}{
@@ -221,6 +624,36 @@ var ToolDefinitions = map[string]*mcp.Tool{
 					"type":        "string",
 					"description": "URI of ingested job description (jd://[uuid])",
 				},
+				"blind": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Anonymize the CV (names, pronouns, age, photos, nationality, marital status, graduation years) before scoring",
+					"default":     false,
+				},
+				"explain": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Attribute the score to components, skills and terms with point deltas; rescores the CV once per factor, so it is much slower",
+					"default":     false,
+				},
+				"rules": map[string]interface{}{
+					"type":        "string",
+					"description": "Knockout screening rules as YAML (required_skills, min_years, languages, location, degree) or expressions, one per line or separated by semicolons (e.g. \"skill Go; years >= 5; language English >= B2; location in EU; degree >= bachelor\")",
+				},
+				"profile": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional scoring profile trained by train_weights; its weights replace the defaults",
+				},
+				"family": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional JD family (e.g. 'backend'); the score is also calibrated against applicants to every JD of the family",
+				},
+				"rule_status": map[string]interface{}{
+					"type":        "array",
+					"description": "Only list rule results with these statuses; screening_status still covers every rule",
+					"items": map[string]interface{}{
+						"type": "string",
+						"enum": []string{"pass", "fail", "needs_review"},
+					},
+				},
 			},
 			"required": []string{"cv_uri", "jd_uri"},
 		},
@@ -245,6 +678,12 @@ var PromptDefinitions = []*mcp.Prompt{
 				Description: "URI of ingested job description (jd://[uuid])",
 				Required:    true,
 			},
+			{
+				Name:        "blind",
+				Title:       "Blind Screening",
+				Description: "Set to 'true' to analyze the anonymized CV (cv://[uuid]/blind)",
+				Required:    false,
+			},
 		},
 	},
 }
@@ -267,10 +706,28 @@ var ResourceTemplateDefinitions = []mcp.ResourceTemplate{
 		Description: "Access a stored CV document by its UUID",
 		MIMEType:    "text/markdown",
 	},
+	{
+		URITemplate: "cv://{id}/blind",
+		Name:        "Anonymized CV Document",
+		Description: "Access a stored CV with names, pronouns, age, photos, nationality, marital status and graduation years masked",
+		MIMEType:    "text/markdown",
+	},
+	{
+		URITemplate: "cv://{id}/structured",
+		Name:        "Structured CV",
+		Description: "Parsed CV model as JSON: contact, summary, experience, education, skills, languages, projects and certifications",
+		MIMEType:    "application/json",
+	},
 	{
 		URITemplate: "jd://{id}",
 		Name:        "Job Description",
 		Description: "Access a stored job description by its UUID",
 		MIMEType:    "text/markdown",
 	},
+	{
+		URITemplate: "jd://{id}/structured",
+		Name:        "Structured Job Description",
+		Description: "Parsed job description model as JSON: title, company, location, employment type, salary range, responsibilities, required and preferred qualifications and benefits",
+		MIMEType:    "application/json",
+	},
 }
@@ -0,0 +1,130 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ScreeningRulesRecord is the stored set of screening rules of a job description
+type ScreeningRulesRecord struct {
+	JdURI     string          `json:"jd_uri"`
+	Rules     string          `json:"rules"`  // As given, YAML or expressions
+	Parsed    []analysis.Rule `json:"parsed"` // The rules analyze_cv_jd evaluates
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// readScreeningRules returns the rules stored for a job description, or "" when none are stored
+func readScreeningRules(sm *storage.StorageManager, jdURI string) (string, error) {
+	data, err := sm.ReadRules(jdURI)
+	if err != nil || data == nil {
+		return "", err
+	}
+	var record ScreeningRulesRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return "", fmt.Errorf("decode rules of %s: %w", jdURI, err)
+	}
+	return record.Rules, nil
+}
+
+// SetScreeningRulesTool stores the screening rules of a job description, so every analysis against
+// it is screened without passing the rules again
+type SetScreeningRulesTool struct {
+	storageManager *storage.StorageManager
+	logger         *slog.Logger
+}
+
+// NewSetScreeningRulesTool creates a new set screening rules tool
+func NewSetScreeningRulesTool(sm *storage.StorageManager) *SetScreeningRulesTool {
+	return &SetScreeningRulesTool{
+		storageManager: sm,
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *SetScreeningRulesTool) WithLogger(logger *slog.Logger) *SetScreeningRulesTool {
+	t.logger = logger
+	return t
+}
+
+// Call implements the MCP tool interface
+func (t *SetScreeningRulesTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		JdURI string `json:"jd_uri"`
+		Rules string `json:"rules"` // Empty removes the stored rules
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if _, errResult, err := readDocumentArg(t.storageManager, "jd_uri", args.JdURI, storage.DocumentTypeJD); err != nil {
+		return errResult, err
+	}
+
+	if strings.TrimSpace(args.Rules) == "" {
+		if err := t.storageManager.DeleteRules(args.JdURI); err != nil {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("Error: failed to remove rules: %v", err)},
+				},
+			}, err
+		}
+		t.logger.InfoContext(ctx, "screening rules removed", "jd_uri", args.JdURI)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Screening rules removed from %s", args.JdURI)},
+			},
+		}, nil
+	}
+
+	rules, err := analysis.ParseRules(args.Rules)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: rules - %v", err)},
+			},
+		}, &ValidationError{Field: "rules", Value: args.Rules, Reason: err.Error()}
+	}
+
+	record := ScreeningRulesRecord{
+		JdURI:     args.JdURI,
+		Rules:     args.Rules,
+		Parsed:    rules,
+		UpdatedAt: time.Now().UTC(),
+	}
+	jsonData, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+	if err := t.storageManager.SaveRules(args.JdURI, jsonData); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to save rules: %v", err)},
+			},
+		}, err
+	}
+
+	t.logger.InfoContext(ctx, "screening rules stored", "jd_uri", args.JdURI, "rules", len(rules))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
@@ -0,0 +1,101 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreeningRules(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Platform Engineer\n\n## Requirements\n- Go\n- Kubernetes\n- Docker\n"), "jd.md")
+	require.NoError(t, err)
+	cvs := make(map[string]string)
+	for name, skills := range map[string]string{"alice": "Go, Kubernetes, Docker", "bob": "Python, Docker", "carol": "Golang"} {
+		uri, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Candidate\n\n## Skills\n"+skills+"\n"), name+".md")
+		require.NoError(t, err)
+		cvs[name] = uri
+	}
+
+	setRules := NewSetScreeningRulesTool(sm)
+	text, err := callTool(t, setRules.Call, map[string]interface{}{"jd_uri": jdURI, "rules": "skill Go"})
+	require.NoError(t, err)
+	var record ScreeningRulesRecord
+	require.NoError(t, json.Unmarshal([]byte(text), &record))
+	assert.Equal(t, []analysis.Rule{{Kind: analysis.RuleSkill, Values: []string{"Go"}}}, record.Parsed)
+
+	analyzeTool := NewAnalyzeTool(sm).WithHistory(true)
+	analyze := func(name string) AnalyzeResult {
+		text, err := callTool(t, analyzeTool.Call, map[string]interface{}{"cv_uri": cvs[name], "jd_uri": jdURI})
+		require.NoError(t, err)
+		var result AnalyzeResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		return result
+	}
+
+	t.Run("analyze_cv_jd applies the stored rules", func(t *testing.T) {
+		assert.Equal(t, analysis.CheckPass, analyze("alice").ScreeningStatus)
+		assert.Equal(t, analysis.CheckFail, analyze("bob").ScreeningStatus)
+		// Screening resolves aliases the same way scoring does
+		assert.Equal(t, analysis.CheckPass, analyze("carol").ScreeningStatus)
+		analyze("bob")
+	})
+
+	list := NewListAnalysesTool(sm)
+	listAnalyses := func(args map[string]interface{}) []AnalysisSummary {
+		text, err := callTool(t, list.Call, args)
+		require.NoError(t, err)
+		var result ListAnalysesResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		return result.Analyses
+	}
+
+	t.Run("list_analyses ranks candidates filtered by screening status", func(t *testing.T) {
+		passed := listAnalyses(map[string]interface{}{"jd_uri": jdURI, "screening_status": []string{"pass"}, "rank": true})
+		require.Len(t, passed, 2)
+		assert.Equal(t, cvs["alice"], passed[0].CvURI)
+		assert.Equal(t, 1, passed[0].Rank)
+		assert.Equal(t, 2, passed[1].Rank)
+		assert.GreaterOrEqual(t, passed[0].WeightedScore, passed[1].WeightedScore)
+
+		// Ranking keeps the latest analysis of each candidate
+		ranked := listAnalyses(map[string]interface{}{"jd_uri": jdURI, "rank": true})
+		assert.Len(t, ranked, 3)
+		assert.Len(t, listAnalyses(map[string]interface{}{"jd_uri": jdURI}), 4)
+
+		failed := listAnalyses(map[string]interface{}{"screening_status": []string{"fail"}})
+		require.Len(t, failed, 2)
+		assert.Equal(t, cvs["bob"], failed[0].CvURI)
+	})
+
+	t.Run("empty rules remove the stored rules", func(t *testing.T) {
+		_, err := callTool(t, setRules.Call, map[string]interface{}{"jd_uri": jdURI, "rules": ""})
+		require.NoError(t, err)
+		assert.Empty(t, analyze("bob").ScreeningStatus)
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		_, err := callTool(t, setRules.Call, map[string]interface{}{"jd_uri": jdURI, "rules": "height >= 180"})
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "rules", validationErr.Field)
+
+		_, err = callTool(t, setRules.Call, map[string]interface{}{"jd_uri": cvs["alice"], "rules": "skill Go"})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "jd_uri", validationErr.Field)
+
+		_, err = callTool(t, list.Call, map[string]interface{}{"screening_status": []string{"maybe"}})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "screening_status", validationErr.Field)
+	})
+}
@@ -130,8 +130,11 @@ func NewServer(cfg Config, logger *slog.Logger) (*Server, error) {
 		return nil, fmt.Errorf("storage init: %w", err)
 	}
 
-	// Initialize document converter
-	documentConverter := converter.NewPDFConverter()
+	// Initialize document converters: PDF text extraction, JSON Resume and Europass XML
+	documentConverter := converter.NewMultiConverter(
+		converter.NewPDFConverter(),
+		converter.NewResumeConverter(),
+	)
 
 	// Create server instance
 	s := &Server{
@@ -176,7 +179,9 @@ func (s *Server) registerResources() {
 	s.mcpServer.AddResource(ResourceDefinitions[0], cvHandler.ReadResource)
 
 	// Storage resource handler
-	storageHandler := NewStorageResourceHandler(s.storageManager)
+	storageHandler := NewStorageResourceHandler(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening)
 
 	// Register individual storage resources
 	for _, resource := range storageHandler.ListResources() {
@@ -187,12 +192,20 @@ func (s *Server) registerResources() {
 	for _, template := range storageHandler.ListResourceTemplates() {
 		s.mcpServer.AddResourceTemplate(&template, storageHandler.ReadResource)
 	}
+
+	// Stored analyses (analysis://{id})
+	analysisHandler := NewAnalysisResourceHandler(s.storageManager).WithLogger(s.logger)
+	for _, template := range analysisHandler.ListResourceTemplates() {
+		s.mcpServer.AddResourceTemplate(&template, analysisHandler.ReadResource)
+	}
 }
 
 // registerTools registers all tool handlers
 func (s *Server) registerTools() {
 	// Create ingestor
-	ingestor := ingest.NewIngestor(s.storageManager, s.documentConverter).WithLogger(s.logger)
+	ingestor := ingest.NewIngestor(s.storageManager, s.documentConverter).
+		WithLogger(s.logger).
+		WithDuplicateThreshold(s.duplicateThreshold())
 
 	// ingest_document tool
 	ingestTool := NewIngestDocumentTool(ingestor).WithLogger(s.logger)
@@ -210,15 +223,91 @@ func (s *Server) registerTools() {
 	interviewQuestionsTool := NewInterviewQuestionsTool(s.storageManager).WithLogger(s.logger)
 	s.mcpServer.AddTool(ToolDefinitions["generate_interview_questions"], interviewQuestionsTool.Call)
 
+	// find_duplicates tool
+	findDuplicatesTool := NewFindDuplicatesTool(s.storageManager).
+		WithLogger(s.logger).
+		WithThreshold(s.duplicateThreshold())
+	s.mcpServer.AddTool(ToolDefinitions["find_duplicates"], findDuplicatesTool.Call)
+
+	// export_cv tool
+	exportCVTool := NewExportCVTool(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening)
+	s.mcpServer.AddTool(ToolDefinitions["export_cv"], exportCVTool.Call)
+
 	// analyze_cv_jd tool
-	analyzeTool := NewAnalyzeTool(s.storageManager).WithLogger(s.logger)
+	analyzeTool := NewAnalyzeTool(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening).
+		WithRecencyHalfLife(s.config.RecencyHalfLife).
+		WithSalaryConfig(s.config.SalaryConfig()).
+		WithAnalysisCache(s.config.AnalysisCache).
+		WithHistory(s.config.AnalysisHistory).
+		WithCalibration(s.config.ScoreCalibration)
 	s.mcpServer.AddTool(ToolDefinitions["analyze_cv_jd"], analyzeTool.Call)
+
+	// list_analyses tool
+	listAnalysesTool := NewListAnalysesTool(s.storageManager).WithLogger(s.logger)
+	s.mcpServer.AddTool(ToolDefinitions["list_analyses"], listAnalysesTool.Call)
+
+	// set_screening_rules tool
+	setScreeningRulesTool := NewSetScreeningRulesTool(s.storageManager).WithLogger(s.logger)
+	s.mcpServer.AddTool(ToolDefinitions["set_screening_rules"], setScreeningRulesTool.Call)
+
+	// compare_cv_versions tool
+	compareCVVersionsTool := NewCompareCVVersionsTool(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening).
+		WithRecencyHalfLife(s.config.RecencyHalfLife)
+	s.mcpServer.AddTool(ToolDefinitions["compare_cv_versions"], compareCVVersionsTool.Call)
+
+	// suggest_cv_improvements tool
+	suggestCVImprovementsTool := NewSuggestCVImprovementsTool(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening).
+		WithRecencyHalfLife(s.config.RecencyHalfLife)
+	s.mcpServer.AddTool(ToolDefinitions["suggest_cv_improvements"], suggestCVImprovementsTool.Call)
+
+	// simulate_score tool
+	simulateScoreTool := NewSimulateScoreTool(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening).
+		WithRecencyHalfLife(s.config.RecencyHalfLife)
+	s.mcpServer.AddTool(ToolDefinitions["simulate_score"], simulateScoreTool.Call)
+
+	// record_outcome tool
+	recordOutcomeTool := NewRecordOutcomeTool(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening).
+		WithRecencyHalfLife(s.config.RecencyHalfLife)
+	s.mcpServer.AddTool(ToolDefinitions["record_outcome"], recordOutcomeTool.Call)
+
+	// train_weights tool
+	trainWeightsTool := NewTrainWeightsTool(s.storageManager).WithLogger(s.logger)
+	s.mcpServer.AddTool(ToolDefinitions["train_weights"], trainWeightsTool.Call)
+
+	// compare_engines tool
+	compareEnginesTool := NewCompareEnginesTool(s.storageManager).
+		WithLogger(s.logger).
+		WithRecencyHalfLife(s.config.RecencyHalfLife).
+		WithSalaryConfig(s.config.SalaryConfig())
+	s.mcpServer.AddTool(ToolDefinitions["compare_engines"], compareEnginesTool.Call)
+}
+
+// duplicateThreshold returns the configured near-duplicate threshold, falling back to the default
+func (s *Server) duplicateThreshold() float64 {
+	if s.config.DuplicateThreshold <= 0 || s.config.DuplicateThreshold > 1 {
+		return storage.DefaultDuplicateThreshold
+	}
+	return s.config.DuplicateThreshold
 }
 
 // registerPrompts registers all prompt handlers
 func (s *Server) registerPrompts() {
 	// analyze_fit prompt
-	analyzeFitPrompt := NewAnalyzeFitPrompt(s.storageManager).WithLogger(s.logger)
+	analyzeFitPrompt := NewAnalyzeFitPrompt(s.storageManager).
+		WithLogger(s.logger).
+		WithBlindScreening(s.config.BlindScreening)
 	for _, promptDef := range PromptDefinitions {
 		s.mcpServer.AddPrompt(promptDef, analyzeFitPrompt.Handle)
 	}
@@ -0,0 +1,180 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// SimulateScoreTool rescores a CV with hypothetical edits applied
+type SimulateScoreTool struct {
+	storageManager *storage.StorageManager
+	engine         *analysis.AnalysisEngine
+	logger         *slog.Logger
+	blindScreening bool
+}
+
+// NewSimulateScoreTool creates a new simulate score tool
+func NewSimulateScoreTool(sm *storage.StorageManager) *SimulateScoreTool {
+	return &SimulateScoreTool{
+		storageManager: sm,
+		engine:         analysis.NewAnalysisEngine(),
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *SimulateScoreTool) WithLogger(logger *slog.Logger) *SimulateScoreTool {
+	t.logger = logger
+	return t
+}
+
+// WithBlindScreening anonymizes every CV before scoring
+func (t *SimulateScoreTool) WithBlindScreening(blind bool) *SimulateScoreTool {
+	t.blindScreening = blind
+	return t
+}
+
+// WithRecencyHalfLife sets the years after which an unused CV skill counts half (<= 0 disables)
+func (t *SimulateScoreTool) WithRecencyHalfLife(years float64) *SimulateScoreTool {
+	t.engine.WithRecencyHalfLife(years)
+	return t
+}
+
+// SimulateScoreResult is the structured simulate_score output
+type SimulateScoreResult struct {
+	CvURI              string                   `json:"cv_uri"`
+	JdURI              string                   `json:"jd_uri"`
+	Edits              []analysis.ScoreEdit     `json:"edits"`
+	OriginalScore      int                      `json:"original_score"`
+	SimulatedScore     int                      `json:"simulated_score"`
+	OriginalBreakdown  *analysis.ScoreBreakdown `json:"original_breakdown"`
+	SimulatedBreakdown *analysis.ScoreBreakdown `json:"simulated_breakdown"`
+	ScoreDelta         analysis.ScoreBreakdown  `json:"score_delta"`
+	MissingSkills      []string                 `json:"missing_skills"` // JD skills still missing after the edits
+	Summary            string                   `json:"summary"`
+}
+
+// Call implements the MCP tool interface
+func (t *SimulateScoreTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		CvURI string   `json:"cv_uri"`
+		JdURI string   `json:"jd_uri"`
+		Edits []string `json:"edits"`
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	edits, err := analysis.ParseScoreEdits(args.Edits, analysis.NewSkillsDictionary())
+	if err == nil && len(edits) == 0 {
+		err = fmt.Errorf("at least one edit is required")
+	}
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: edits - %v", err)},
+			},
+		}, &ValidationError{Field: "edits", Value: strings.Join(args.Edits, "; "), Reason: err.Error()}
+	}
+
+	cvContent, errResult, err := readDocumentArg(t.storageManager, "cv_uri", args.CvURI, storage.DocumentTypeCV)
+	if err != nil {
+		return errResult, err
+	}
+	jdContent, errResult, err := readDocumentArg(t.storageManager, "jd_uri", args.JdURI, storage.DocumentTypeJD)
+	if err != nil {
+		return errResult, err
+	}
+	if t.blindScreening {
+		cvContent = analysis.Anonymize(cvContent)
+	}
+
+	original, err := t.engine.Analyze(ctx, cvContent, jdContent)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: analysis failed: %v", err)},
+			},
+		}, err
+	}
+	simulated, err := t.engine.Simulate(ctx, cvContent, jdContent, edits)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: simulation failed: %v", err)},
+			},
+		}, err
+	}
+
+	result := SimulateScoreResult{
+		CvURI:              args.CvURI,
+		JdURI:              args.JdURI,
+		Edits:              edits,
+		OriginalScore:      original.WeightedScore,
+		SimulatedScore:     simulated.WeightedScore,
+		OriginalBreakdown:  original.ScoringBreakdown,
+		SimulatedBreakdown: simulated.ScoringBreakdown,
+		MissingSkills:      analysis.MissingRequirements(ctx, analysis.ApplyScoreEdits(cvContent, edits), jdContent),
+	}
+	if original.ScoringBreakdown != nil && simulated.ScoringBreakdown != nil {
+		result.ScoreDelta = analysis.ScoreDelta(original.ScoringBreakdown, simulated.ScoringBreakdown)
+	}
+	result.Summary = buildSimulationSummary(result)
+
+	t.logger.DebugContext(ctx, "simulated score",
+		"cv_uri", args.CvURI,
+		"jd_uri", args.JdURI,
+		"edits", len(edits),
+		"original_score", result.OriginalScore,
+		"simulated_score", result.SimulatedScore,
+	)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
+
+// buildSimulationSummary creates a human-readable summary of a what-if simulation
+func buildSimulationSummary(result SimulateScoreResult) string {
+	var sb strings.Builder
+
+	sb.WriteString("Edits:\n")
+	for _, edit := range result.Edits {
+		sb.WriteString(fmt.Sprintf("  - %s\n", edit))
+	}
+
+	sb.WriteString(fmt.Sprintf("\nWeighted Score: %d -> %d (%+d)\n", result.OriginalScore, result.SimulatedScore, result.SimulatedScore-result.OriginalScore))
+	sb.WriteString(fmt.Sprintf("  Skill coverage: %+.1f%%\n", result.ScoreDelta.SkillCoverage*100))
+	sb.WriteString(fmt.Sprintf("  Experience: %+.1f%%\n", result.ScoreDelta.Experience*100))
+	sb.WriteString(fmt.Sprintf("  Term similarity: %+.1f%%\n", result.ScoreDelta.TermSimilarity*100))
+	sb.WriteString(fmt.Sprintf("  Proficiency: %+.1f%%\n", result.ScoreDelta.Proficiency*100))
+	sb.WriteString(fmt.Sprintf("  Overall match: %+.1f%%\n", result.ScoreDelta.OverallMatch*100))
+
+	if len(result.MissingSkills) > 0 {
+		sb.WriteString(fmt.Sprintf("\nStill Missing: %s\n", strings.Join(result.MissingSkills, ", ")))
+	}
+	return sb.String()
+}
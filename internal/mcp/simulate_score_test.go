@@ -0,0 +1,89 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateScoreTool_Call(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvText := []byte("# Jane Doe\n\n## Skills\nGo, PHP\n")
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, cvText, "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Platform Engineer\n\n## Requirements\n- Go\n- Kubernetes\n"), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewSimulateScoreTool(sm)
+
+	t.Run("rescores with edits", func(t *testing.T) {
+		text, err := callTool(t, tool.Call, map[string]interface{}{
+			"cv_uri": cvURI,
+			"jd_uri": jdURI,
+			"edits":  []string{"add skill Kubernetes with 2 years", "remove PHP"},
+		})
+		require.NoError(t, err)
+
+		var result SimulateScoreResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		assert.Equal(t, []analysis.ScoreEdit{
+			{Kind: analysis.EditAddSkill, Skill: "Kubernetes", Years: 2},
+			{Kind: analysis.EditRemoveSkill, Skill: "PHP"},
+		}, result.Edits)
+		assert.Greater(t, result.SimulatedScore, result.OriginalScore)
+		assert.Equal(t, result.SimulatedScore-result.OriginalScore, result.ScoreDelta.WeightedTotal)
+		assert.Positive(t, result.ScoreDelta.SkillCoverage)
+		assert.Empty(t, result.MissingSkills)
+		assert.Contains(t, result.Summary, "Overall match:")
+
+		// The stored CV is unchanged
+		stored, err := sm.ReadDocument(cvURI)
+		require.NoError(t, err)
+		assert.Contains(t, string(stored), "Go, PHP")
+	})
+
+	t.Run("blind screening scores the anonymized CV", func(t *testing.T) {
+		raw := "# Jane Doe\n\n## Summary\nJane Doe is a German engineer, married, born 1985. She ships Go.\n\n## Skills\nGo, PHP\n"
+		blindURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(raw), "blind-cv.md")
+		require.NoError(t, err)
+		maskedURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte(analysis.Anonymize(raw)), "masked-cv.md")
+		require.NoError(t, err)
+
+		args := map[string]interface{}{"cv_uri": blindURI, "jd_uri": jdURI, "edits": []string{"add skill Kubernetes"}}
+		text, err := callTool(t, NewSimulateScoreTool(sm).WithBlindScreening(true).Call, args)
+		require.NoError(t, err)
+		var blind SimulateScoreResult
+		require.NoError(t, json.Unmarshal([]byte(text), &blind))
+
+		args["cv_uri"] = maskedURI
+		text, err = callTool(t, tool.Call, args)
+		require.NoError(t, err)
+		var masked SimulateScoreResult
+		require.NoError(t, json.Unmarshal([]byte(text), &masked))
+
+		assert.Equal(t, masked.OriginalBreakdown, blind.OriginalBreakdown)
+		assert.Equal(t, masked.SimulatedBreakdown, blind.SimulatedBreakdown)
+	})
+
+	t.Run("validates edits", func(t *testing.T) {
+		var validationErr *ValidationError
+
+		_, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "edits", validationErr.Field)
+
+		_, err = callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI, "edits": []string{"learn telepathy"}})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "edits", validationErr.Field)
+	})
+}
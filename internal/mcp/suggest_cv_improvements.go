@@ -0,0 +1,148 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// SuggestCVImprovementsTool suggests deterministic CV improvements for a job description
+type SuggestCVImprovementsTool struct {
+	storageManager *storage.StorageManager
+	engine         *analysis.AnalysisEngine
+	logger         *slog.Logger
+	blindScreening bool
+}
+
+// NewSuggestCVImprovementsTool creates a new suggest CV improvements tool
+func NewSuggestCVImprovementsTool(sm *storage.StorageManager) *SuggestCVImprovementsTool {
+	return &SuggestCVImprovementsTool{
+		storageManager: sm,
+		engine:         analysis.NewAnalysisEngine(),
+		logger:         slog.Default(),
+	}
+}
+
+// WithLogger sets the logger for the tool
+func (t *SuggestCVImprovementsTool) WithLogger(logger *slog.Logger) *SuggestCVImprovementsTool {
+	t.logger = logger
+	return t
+}
+
+// WithBlindScreening anonymizes every CV before suggesting improvements
+func (t *SuggestCVImprovementsTool) WithBlindScreening(blind bool) *SuggestCVImprovementsTool {
+	t.blindScreening = blind
+	return t
+}
+
+// WithRecencyHalfLife sets the years after which an unused CV skill counts half (<= 0 disables)
+func (t *SuggestCVImprovementsTool) WithRecencyHalfLife(years float64) *SuggestCVImprovementsTool {
+	t.engine.WithRecencyHalfLife(years)
+	return t
+}
+
+// SuggestCVImprovementsResult is the structured suggest_cv_improvements output
+type SuggestCVImprovementsResult struct {
+	CvURI         string                `json:"cv_uri"`
+	JdURI         string                `json:"jd_uri"`
+	WeightedScore int                   `json:"weighted_score"`
+	Suggestions   []analysis.Suggestion `json:"suggestions"`
+	Summary       string                `json:"summary"`
+}
+
+// Call implements the MCP tool interface
+func (t *SuggestCVImprovementsTool) Call(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	var args struct {
+		CvURI string `json:"cv_uri"`
+		JdURI string `json:"jd_uri"`
+	}
+
+	if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: invalid JSON format - %v", err)},
+			},
+		}, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cvContent, errResult, err := readDocumentArg(t.storageManager, "cv_uri", args.CvURI, storage.DocumentTypeCV)
+	if err != nil {
+		return errResult, err
+	}
+	jdContent, errResult, err := readDocumentArg(t.storageManager, "jd_uri", args.JdURI, storage.DocumentTypeJD)
+	if err != nil {
+		return errResult, err
+	}
+	if t.blindScreening {
+		cvContent = analysis.Anonymize(cvContent)
+	}
+
+	suggestions, base, err := t.engine.SuggestImprovements(ctx, cvContent, jdContent)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: analysis failed: %v", err)},
+			},
+		}, err
+	}
+	if suggestions == nil {
+		suggestions = []analysis.Suggestion{}
+	}
+
+	result := SuggestCVImprovementsResult{
+		CvURI:         args.CvURI,
+		JdURI:         args.JdURI,
+		WeightedScore: base.WeightedScore,
+		Suggestions:   suggestions,
+		Summary:       buildSuggestionSummary(base.WeightedScore, suggestions),
+	}
+
+	t.logger.DebugContext(ctx, "suggested CV improvements",
+		"cv_uri", args.CvURI,
+		"jd_uri", args.JdURI,
+		"suggestions", len(suggestions),
+	)
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Error: failed to format result: %v", err)},
+			},
+		}, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonData)},
+		},
+	}, nil
+}
+
+// buildSuggestionSummary creates a human-readable list of suggestions
+func buildSuggestionSummary(score int, suggestions []analysis.Suggestion) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Current Weighted Score: %d\n", score))
+
+	if len(suggestions) == 0 {
+		sb.WriteString("\nNo improvements found.\n")
+		return sb.String()
+	}
+
+	sb.WriteString("\nSuggestions (by expected score impact; [-] is not simulated):\n")
+	for i, s := range suggestions {
+		impact := "-"
+		if s.Simulated {
+			impact = fmt.Sprintf("%+d", s.ScoreImpact)
+		}
+		sb.WriteString(fmt.Sprintf("  %d. [%s] %s\n", i+1, impact, s.Issue))
+		sb.WriteString(fmt.Sprintf("     %s\n", s.Hint))
+	}
+	return sb.String()
+}
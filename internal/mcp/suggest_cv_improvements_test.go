@@ -0,0 +1,75 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kfreiman/vibecheck/internal/analysis"
+	"github.com/kfreiman/vibecheck/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestCVImprovementsTool_Call(t *testing.T) {
+	sm, err := storage.NewStorageManager(storage.StorageConfig{
+		BasePath:   t.TempDir(),
+		DefaultTTL: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Experience\n### Developer - Acme\n2020 - Present\n- Built APIs in Go\n"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(storage.DocumentTypeJD, []byte("# Platform Engineer\n\nWe need Go and Kubernetes.\n"), "jd.md")
+	require.NoError(t, err)
+
+	tool := NewSuggestCVImprovementsTool(sm)
+
+	t.Run("lists suggestions with score impact", func(t *testing.T) {
+		text, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": cvURI, "jd_uri": jdURI})
+		require.NoError(t, err)
+
+		var result SuggestCVImprovementsResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		require.NotEmpty(t, result.Suggestions)
+
+		kinds := make(map[string]bool)
+		for _, s := range result.Suggestions {
+			kinds[s.Kind] = true
+		}
+		assert.True(t, kinds[analysis.SuggestMissingRequirement])
+		assert.True(t, kinds[analysis.SuggestUnquantifiedClaim])
+		assert.True(t, kinds[analysis.SuggestWeakSection])
+		assert.Equal(t, analysis.SuggestMissingRequirement, result.Suggestions[0].Kind)
+		assert.Positive(t, result.Suggestions[0].ScoreImpact)
+		assert.Contains(t, result.Summary, "Suggestions (by expected score impact; [-] is not simulated):")
+		for _, s := range result.Suggestions {
+			if s.Kind == analysis.SuggestUnquantifiedClaim {
+				assert.False(t, s.Simulated)
+				assert.Zero(t, s.ScoreImpact)
+			}
+		}
+	})
+
+	t.Run("blind screening masks the CV", func(t *testing.T) {
+		blindCV, err := sm.SaveDocument(storage.DocumentTypeCV, []byte("# Jane Doe\n\n## Summary\nJane Doe is a developer. She built APIs.\n\n## Experience\n### Developer - Acme\n2020 - Present\n- She built APIs in Go\n"), "blind-cv.md")
+		require.NoError(t, err)
+
+		blindTool := NewSuggestCVImprovementsTool(sm).WithBlindScreening(true)
+		text, err := callTool(t, blindTool.Call, map[string]interface{}{"cv_uri": blindCV, "jd_uri": jdURI})
+		require.NoError(t, err)
+
+		var result SuggestCVImprovementsResult
+		require.NoError(t, json.Unmarshal([]byte(text), &result))
+		require.NotEmpty(t, result.Suggestions)
+		assert.NotContains(t, text, "Jane")
+		assert.NotContains(t, text, "She ")
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		_, err := callTool(t, tool.Call, map[string]interface{}{"cv_uri": jdURI, "jd_uri": jdURI})
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "cv_uri", validationErr.Field)
+	})
+}
@@ -0,0 +1,468 @@
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CV is the typed candidate model extracted from a stored CV
+type CV struct {
+	Contact        Contact           `json:"contact"`
+	Headline       string            `json:"headline,omitempty"`
+	Summary        string            `json:"summary,omitempty"`
+	Experience     []ExperienceEntry `json:"experience"`
+	Education      []EducationEntry  `json:"education"`
+	Skills         []string          `json:"skills"`
+	Languages      []Language        `json:"languages"`
+	Projects       []Project         `json:"projects"`
+	Certifications []string          `json:"certifications"`
+	Sections       []Section         `json:"sections"`
+}
+
+// Contact holds candidate contact details
+type Contact struct {
+	Name     string   `json:"name,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Phone    string   `json:"phone,omitempty"`
+	Location string   `json:"location,omitempty"`
+	Links    []string `json:"links,omitempty"`
+}
+
+// ExperienceEntry is a single role in the candidate's work history
+type ExperienceEntry struct {
+	Title        string    `json:"title,omitempty"`
+	Company      string    `json:"company,omitempty"`
+	Dates        DateRange `json:"dates"`
+	Bullets      []string  `json:"bullets,omitempty"`
+	Technologies []string  `json:"technologies,omitempty"`
+}
+
+// EducationEntry is a degree, school or program
+type EducationEntry struct {
+	Institution string    `json:"institution,omitempty"`
+	Degree      string    `json:"degree,omitempty"`
+	Dates       DateRange `json:"dates"`
+	Details     []string  `json:"details,omitempty"`
+}
+
+// Language is a spoken language with the level as written in the CV
+type Language struct {
+	Name  string `json:"name"`
+	Level string `json:"level,omitempty"`
+}
+
+// Project is a personal or professional project
+type Project struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Bullets      []string `json:"bullets,omitempty"`
+	Technologies []string `json:"technologies,omitempty"`
+}
+
+var (
+	emailPattern    = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	phonePattern    = regexp.MustCompile(`\+?\d[\d\s()-]{7,}\d`)
+	linkPattern     = regexp.MustCompile(`(?i)\b(?:https?://|www\.|linkedin\.com/|github\.com/)[^\s)>\]]+`)
+	locationPattern = regexp.MustCompile(`(?im)^\s*(?:[-*]\s*)?(?:\*\*)?(?:location|city|address|based in|город|местоположение|адрес)(?:\*\*)?\s*[:：]\s*(?:\*\*)?\s*(.+)$`)
+	nameFieldRegexp = regexp.MustCompile(`(?im)^\s*(?:[-*]\s*)?(?:\*\*)?(?:full name|name|имя|фио)(?:\*\*)?\s*[:：]\s*(?:\*\*)?\s*(.+)$`)
+
+	// techLinePattern matches "Tech: [Go Kubernetes]" or "Stack: Go, SQL"
+	techLinePattern = regexp.MustCompile(`(?i)^(?:tech(?:nologies)?|stack|tech stack|tools|технологии|стек)\s*[:：]\s*(.+)$`)
+
+	// roleSeparatorPattern splits "Title @ Company", "Title at Company", "Title | Company"
+	roleSeparatorPattern = regexp.MustCompile(`\s+(?:@|at|в|—|–|-|\|)\s+|\s*@\s*|,\s+`)
+
+	// degreePattern recognizes degree names
+	degreePattern = regexp.MustCompile(`(?i)\b(?:b\.?sc?|m\.?sc?|b\.?a|m\.?a|mba|ph\.?d|bachelor|master|doctor|diploma|associate|бакалавр|магистр|специалист|аспирант|кандидат наук)\w*`)
+
+	// languageLevelPattern separates "English (C1)", "German - B2", "Русский — родной"
+	languageLevelPattern = regexp.MustCompile(`^([^(:–—-]+?)\s*(?:\(([^)]+)\)|[:–—-]\s*(.+))?$`)
+
+	// titleSplitPattern splits "Jane Doe - Backend Engineer" title headings
+	titleSplitPattern = regexp.MustCompile(`\s+[-–—|]\s+`)
+
+	// educationSplitPattern splits "BS Computer Science - State University"
+	educationSplitPattern = regexp.MustCompile(`\s+[-–—|]\s+|,\s+`)
+
+	// projectNamePattern separates "Name: description" or "Name - description"
+	projectNamePattern = regexp.MustCompile(`^([^:–—]+?)\s*(?::|–|—| - )\s*(.+)$`)
+)
+
+// ParseCV parses CV markdown (or PDF-extracted text) into a typed candidate model
+func ParseCV(content string) *CV {
+	title, sections := splitSections(content, cvSectionKeywords)
+
+	cv := &CV{
+		Experience:     []ExperienceEntry{},
+		Education:      []EducationEntry{},
+		Skills:         []string{},
+		Languages:      []Language{},
+		Projects:       []Project{},
+		Certifications: []string{},
+		Sections:       sections,
+	}
+
+	cv.Contact, cv.Headline = parseContact(content, title)
+
+	var headerText []string
+	for _, section := range sections {
+		switch section.Kind {
+		case SectionHeader, SectionContact:
+			headerText = append(headerText, section.Content)
+		case SectionSummary:
+			cv.Summary = joinNonEmpty(cv.Summary, section.Content)
+		case SectionExperience:
+			cv.Experience = append(cv.Experience, parseExperienceEntries(section.Content)...)
+		case SectionEducation:
+			cv.Education = append(cv.Education, parseEducationEntries(section.Content)...)
+		case SectionSkills:
+			cv.Skills = appendUnique(cv.Skills, parseSkillList(section.Content)...)
+		case SectionLanguages:
+			cv.Languages = append(cv.Languages, parseLanguages(section.Content)...)
+		case SectionProjects:
+			cv.Projects = append(cv.Projects, parseProjects(section.Content)...)
+		case SectionCertifications:
+			for _, line := range contentLines(section.Content) {
+				cv.Certifications = append(cv.Certifications, stripBullet(line))
+			}
+		}
+	}
+
+	// Without a summary heading, free text in the header is the summary
+	if cv.Summary == "" {
+		cv.Summary = headerSummary(strings.Join(headerText, "\n"))
+	}
+
+	// Technologies listed inside roles also count as skills
+	for _, entry := range cv.Experience {
+		cv.Skills = appendUnique(cv.Skills, entry.Technologies...)
+	}
+
+	return cv
+}
+
+// parseContact extracts contact details and a headline from the whole document
+func parseContact(content, title string) (Contact, string) {
+	contact := Contact{
+		Email: emailPattern.FindString(content),
+	}
+
+	// Only look for phones and links in the first part of the document
+	head := content
+	if len(head) > 1500 {
+		head = head[:1500]
+	}
+	if phone := phonePattern.FindString(head); phone != "" && !dateRangePattern.MatchString(phone) {
+		contact.Phone = strings.TrimSpace(phone)
+	}
+	for _, link := range linkPattern.FindAllString(head, -1) {
+		contact.Links = appendUnique(contact.Links, strings.TrimRight(link, ".,;"))
+	}
+	if m := locationPattern.FindStringSubmatch(content); m != nil {
+		contact.Location = strings.Trim(strings.TrimSpace(m[1]), "*")
+	}
+	if m := nameFieldRegexp.FindStringSubmatch(content); m != nil {
+		contact.Name = strings.Trim(strings.TrimSpace(m[1]), "*")
+	}
+
+	// Titles look like "Jane Doe", "Jane Doe - Backend Engineer" or "Test CV - Ivan Developer"
+	var headline []string
+	for _, part := range titleSplitPattern.Split(title, -1) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		lower := strings.ToLower(part)
+		if strings.Contains(lower, "cv") || strings.Contains(lower, "resume") || strings.Contains(lower, "резюме") {
+			continue
+		}
+		if contact.Name == "" {
+			contact.Name = part
+			continue
+		}
+		headline = append(headline, part)
+	}
+
+	return contact, strings.Join(headline, " - ")
+}
+
+// headerSummary returns header text that is not contact information
+func headerSummary(text string) string {
+	var lines []string
+	for _, line := range contentLines(text) {
+		line = strings.TrimSpace(line)
+		if emailPattern.MatchString(line) || linkPattern.MatchString(line) || phonePattern.MatchString(line) ||
+			locationPattern.MatchString(line) || nameFieldRegexp.MatchString(line) {
+			continue
+		}
+		lines = append(lines, stripBullet(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseExperienceEntries splits an experience section into roles.
+// A new role starts at a sub-heading or at a line containing a date range;
+// other lines are bullets of the current role.
+func parseExperienceEntries(content string) []ExperienceEntry {
+	var entries []ExperienceEntry
+	var current *ExperienceEntry
+
+	for _, line := range contentLines(content) {
+		if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+			entries = append(entries, newExperienceEntry(m[2]))
+			current = &entries[len(entries)-1]
+			continue
+		}
+
+		text := stripBullet(line)
+		if m := techLinePattern.FindStringSubmatch(text); m != nil && current != nil {
+			current.Technologies = appendUnique(current.Technologies, splitTechnologies(m[1])...)
+			continue
+		}
+
+		if _, _, hasDates := findDateRange(text); hasDates {
+			// "### Company" followed by "Senior Engineer | 2019 - 2021" completes the same role
+			if current != nil && current.Dates.StartYear == 0 && len(current.Bullets) == 0 {
+				completeExperienceEntry(current, text)
+				continue
+			}
+			entries = append(entries, newExperienceEntry(text))
+			current = &entries[len(entries)-1]
+			continue
+		}
+
+		if current == nil {
+			entries = append(entries, newExperienceEntry(text))
+			current = &entries[len(entries)-1]
+			continue
+		}
+		current.Bullets = append(current.Bullets, text)
+	}
+
+	return entries
+}
+
+// newExperienceEntry builds a role from its header line
+func newExperienceEntry(header string) ExperienceEntry {
+	entry := ExperienceEntry{}
+	completeExperienceEntry(&entry, header)
+	return entry
+}
+
+// completeExperienceEntry fills title, company and dates from a header line
+func completeExperienceEntry(entry *ExperienceEntry, header string) {
+	dates, loc, ok := findDateRange(header)
+	if ok {
+		entry.Dates = dates
+		header = header[:loc[0]] + header[loc[1]:]
+	}
+	header = cleanHeader(header)
+	if header == "" {
+		return
+	}
+
+	parts := roleSeparatorPattern.Split(header, 2)
+	switch {
+	case entry.Company == "" && entry.Title == "" && len(parts) == 2:
+		entry.Title, entry.Company = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	case entry.Title == "" && entry.Company == "":
+		entry.Title = header
+	case entry.Company == "":
+		// Heading held the title, the dated line names the company
+		entry.Company = header
+	default:
+		// Heading held the company, the dated line names the title
+		entry.Company, entry.Title = entry.Title, header
+	}
+}
+
+// cleanHeader removes empty parentheses, markdown emphasis and trailing separators
+func cleanHeader(header string) string {
+	header = strings.ReplaceAll(header, "()", "")
+	header = strings.ReplaceAll(header, "**", "")
+	header = strings.ReplaceAll(header, "__", "")
+	return strings.Trim(strings.TrimSpace(header), "|,-–—:() ")
+}
+
+// parseEducationEntries parses education lines into entries
+func parseEducationEntries(content string) []EducationEntry {
+	var entries []EducationEntry
+	var current *EducationEntry
+
+	for _, line := range contentLines(content) {
+		heading := false
+		if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+			line = m[2]
+			heading = true
+		}
+		text := stripBullet(line)
+
+		isEntry := heading || current == nil || isBullet(line) || singleYearPattern.MatchString(text) || degreePattern.MatchString(text)
+		if current != nil && !heading && !isBullet(line) && current.Degree == "" && degreePattern.MatchString(text) {
+			// Degree on its own line under a school heading
+			current.Degree = text
+			continue
+		}
+		if !isEntry {
+			current.Details = append(current.Details, text)
+			continue
+		}
+
+		entry := EducationEntry{}
+		if dates, loc, ok := findDateRange(text); ok {
+			entry.Dates = dates
+			text = text[:loc[0]] + text[loc[1]:]
+		} else if year := extractYear(text); year != 0 {
+			entry.Dates = DateRange{End: fmt.Sprint(year), EndYear: year}
+			text = singleYearPattern.ReplaceAllString(text, "")
+		}
+		text = cleanHeader(text)
+
+		parts := educationSplitPattern.Split(text, 2)
+		if len(parts) == 2 && degreePattern.MatchString(parts[0]) {
+			entry.Degree, entry.Institution = strings.TrimSpace(parts[0]), cleanHeader(parts[1])
+		} else if len(parts) == 2 && degreePattern.MatchString(parts[1]) {
+			entry.Institution, entry.Degree = strings.TrimSpace(parts[0]), cleanHeader(parts[1])
+		} else if degreePattern.MatchString(text) {
+			entry.Degree = text
+		} else {
+			entry.Institution = text
+		}
+
+		entries = append(entries, entry)
+		current = &entries[len(entries)-1]
+	}
+
+	return entries
+}
+
+// parseSkillList parses skills written as lists, "Category: a, b" lines or bullets
+func parseSkillList(content string) []string {
+	var skills []string
+	for _, line := range contentLines(content) {
+		text := stripBullet(line)
+		if idx := strings.IndexAny(text, ":："); idx >= 0 {
+			text = text[idx+1:]
+		}
+		skills = appendUnique(skills, splitTechnologies(text)...)
+	}
+	return skills
+}
+
+// splitTechnologies splits technology lists, including space-separated "[Go Kubernetes AWS]"
+func splitTechnologies(text string) []string {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") && !strings.ContainsAny(text, ",;|") {
+		return strings.Fields(strings.Trim(text, "[]"))
+	}
+	return splitList(text)
+}
+
+// parseLanguages parses "English (C1)" style entries
+func parseLanguages(content string) []Language {
+	var languages []Language
+	for _, line := range contentLines(content) {
+		for _, item := range splitList(stripBullet(line)) {
+			m := languageLevelPattern.FindStringSubmatch(item)
+			if m == nil {
+				languages = append(languages, Language{Name: item})
+				continue
+			}
+			level := strings.TrimSpace(m[2])
+			if level == "" {
+				level = strings.TrimSpace(m[3])
+			}
+			languages = append(languages, Language{Name: strings.TrimSpace(m[1]), Level: level})
+		}
+	}
+	return languages
+}
+
+// parseProjects parses projects introduced by sub-headings or "Name: description" bullets
+func parseProjects(content string) []Project {
+	var projects []Project
+	var current *Project
+
+	for _, line := range contentLines(content) {
+		if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+			projects = append(projects, Project{Name: cleanHeader(m[2])})
+			current = &projects[len(projects)-1]
+			continue
+		}
+
+		text := stripBullet(line)
+		if m := techLinePattern.FindStringSubmatch(text); m != nil && current != nil {
+			current.Technologies = appendUnique(current.Technologies, splitTechnologies(m[1])...)
+			continue
+		}
+
+		// Top-level bullets without a heading are projects themselves
+		if current == nil || (isBullet(line) && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") && current.Description != "") {
+			project := Project{Name: text}
+			if m := projectNamePattern.FindStringSubmatch(text); m != nil {
+				project.Name, project.Description = cleanHeader(m[1]), strings.TrimSpace(m[2])
+			}
+			projects = append(projects, project)
+			current = &projects[len(projects)-1]
+			continue
+		}
+
+		if current.Description == "" && !isBullet(line) {
+			current.Description = text
+			continue
+		}
+		current.Bullets = append(current.Bullets, text)
+	}
+
+	return projects
+}
+
+// joinNonEmpty joins two text blocks with a newline, skipping empty ones
+func joinNonEmpty(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	return a + "\n" + b
+}
+
+// appendUnique appends values that are not already present (case-insensitive)
+func appendUnique(list []string, values ...string) []string {
+	seen := make(map[string]bool, len(list))
+	for _, v := range list {
+		seen[strings.ToLower(v)] = true
+	}
+	for _, v := range values {
+		key := strings.ToLower(strings.TrimSpace(v))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		list = append(list, strings.TrimSpace(v))
+	}
+	return list
+}
+
+// SectionText returns the concatenated content of all sections of the given kind
+func (cv *CV) SectionText(kind SectionKind) string {
+	var parts []string
+	for _, section := range cv.Sections {
+		if section.Kind == kind {
+			parts = append(parts, section.Content)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// ToJSON converts the CV model to JSON
+func (cv *CV) ToJSON() (string, error) {
+	data, err := json.MarshalIndent(cv, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal CV: %w", err)
+	}
+	return string(data), nil
+}
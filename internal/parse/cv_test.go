@@ -0,0 +1,219 @@
+package parse
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleCV = `# Jane Doe - Senior Backend Engineer
+
+jane.doe@example.com | +1 555 123 4567 | https://github.com/janedoe
+Location: Berlin, Germany
+
+## Summary
+Backend engineer with 8 years of experience building distributed systems.
+
+## Work Experience
+
+### Senior Software Engineer @ Acme Corp (Jan 2021 - Present)
+- Led migration to Kubernetes
+- Reduced latency by 40%
+Tech: Go, Kubernetes, PostgreSQL
+
+### Software Engineer at Initech | 2016 - 2020
+- Built billing services in Java
+
+## Education
+- BSc Computer Science - State University, 2012 - 2016
+
+## Skills
+- Languages: Go, Java, Python
+- Databases: PostgreSQL; Redis
+
+## Languages
+- English (C1)
+- German - B2
+
+## Projects
+- vibecheck: MCP server for CV analysis
+- dotfiles: Personal configuration
+
+## Certifications
+- CKA: Certified Kubernetes Administrator
+`
+
+func TestParseCV_Markdown(t *testing.T) {
+	cv := ParseCV(sampleCV)
+
+	assert.Equal(t, "Jane Doe", cv.Contact.Name)
+	assert.Equal(t, "Senior Backend Engineer", cv.Headline)
+	assert.Equal(t, "jane.doe@example.com", cv.Contact.Email)
+	assert.Equal(t, "+1 555 123 4567", cv.Contact.Phone)
+	assert.Equal(t, "Berlin, Germany", cv.Contact.Location)
+	assert.Contains(t, cv.Contact.Links, "https://github.com/janedoe")
+
+	assert.Contains(t, cv.Summary, "8 years of experience")
+
+	require.Len(t, cv.Experience, 2)
+	first := cv.Experience[0]
+	assert.Equal(t, "Senior Software Engineer", first.Title)
+	assert.Equal(t, "Acme Corp", first.Company)
+	assert.Equal(t, 2021, first.Dates.StartYear)
+	assert.True(t, first.Dates.Current)
+	assert.Equal(t, []string{"Led migration to Kubernetes", "Reduced latency by 40%"}, first.Bullets)
+	assert.Equal(t, []string{"Go", "Kubernetes", "PostgreSQL"}, first.Technologies)
+
+	second := cv.Experience[1]
+	assert.Equal(t, "Software Engineer", second.Title)
+	assert.Equal(t, "Initech", second.Company)
+	assert.Equal(t, 2016, second.Dates.StartYear)
+	assert.Equal(t, 2020, second.Dates.EndYear)
+	assert.False(t, second.Dates.Current)
+
+	require.Len(t, cv.Education, 1)
+	assert.Equal(t, "BSc Computer Science", cv.Education[0].Degree)
+	assert.Equal(t, "State University", cv.Education[0].Institution)
+	assert.Equal(t, 2016, cv.Education[0].Dates.EndYear)
+
+	for _, skill := range []string{"Go", "Java", "Python", "PostgreSQL", "Redis", "Kubernetes"} {
+		assert.Contains(t, cv.Skills, skill)
+	}
+
+	assert.Equal(t, []Language{{Name: "English", Level: "C1"}, {Name: "German", Level: "B2"}}, cv.Languages)
+
+	require.Len(t, cv.Projects, 2)
+	assert.Equal(t, "vibecheck", cv.Projects[0].Name)
+	assert.Equal(t, "MCP server for CV analysis", cv.Projects[0].Description)
+
+	assert.Equal(t, []string{"CKA: Certified Kubernetes Administrator"}, cv.Certifications)
+}
+
+func TestParseCV_PlainTextHeadings(t *testing.T) {
+	// Text extracted from PDFs has no markdown headings
+	content := `John Smith
+john@example.com
+
+PROFESSIONAL SUMMARY
+Platform engineer focused on reliability.
+
+EXPERIENCE
+Site Reliability Engineer, Globex 2018 - 2022
+Kept the lights on.
+
+EDUCATION
+MSc Physics, Tech Institute 2017
+
+Skills:
+Terraform, AWS, Go
+`
+	cv := ParseCV(content)
+
+	assert.Equal(t, "Platform engineer focused on reliability.", cv.Summary)
+	require.Len(t, cv.Experience, 1)
+	assert.Equal(t, "Site Reliability Engineer", cv.Experience[0].Title)
+	assert.Equal(t, "Globex", cv.Experience[0].Company)
+	assert.Equal(t, []string{"Kept the lights on."}, cv.Experience[0].Bullets)
+	require.Len(t, cv.Education, 1)
+	assert.Equal(t, 2017, cv.Education[0].Dates.EndYear)
+	assert.Equal(t, []string{"Terraform", "AWS", "Go"}, cv.Skills)
+}
+
+func TestParseCV_SkillHeadingsWithOtherKeywords(t *testing.T) {
+	content := `# Jane Doe
+
+## Programming Languages
+Go, Python, TypeScript
+
+## Key Qualifications
+Kubernetes, Terraform
+
+## Languages
+- English - C1
+
+## Education
+- BSc Computer Science, State University, 2014
+`
+	cv := ParseCV(content)
+
+	assert.Equal(t, []string{"Go", "Python", "TypeScript", "Kubernetes", "Terraform"}, cv.Skills)
+	require.Len(t, cv.Languages, 1)
+	assert.Equal(t, "English", cv.Languages[0].Name)
+	require.Len(t, cv.Education, 1)
+}
+
+func TestParseCV_Russian(t *testing.T) {
+	content := `# Иван Петров
+
+## О себе
+Backend-разработчик.
+
+## Опыт работы
+- Ведущий разработчик в Яндекс (03.2019 - н.в.)
+- Разрабатывал сервисы на Go
+
+## Образование
+- МГУ, бакалавр, 2015
+
+## Навыки
+Go, PostgreSQL, Kafka
+`
+	cv := ParseCV(content)
+
+	assert.Equal(t, "Иван Петров", cv.Contact.Name)
+	assert.Equal(t, "Backend-разработчик.", cv.Summary)
+	require.Len(t, cv.Experience, 1)
+	assert.Equal(t, "Ведущий разработчик", cv.Experience[0].Title)
+	assert.Equal(t, "Яндекс", cv.Experience[0].Company)
+	assert.Equal(t, 2019, cv.Experience[0].Dates.StartYear)
+	assert.True(t, cv.Experience[0].Dates.Current)
+	assert.Equal(t, []string{"Go", "PostgreSQL", "Kafka"}, cv.Skills)
+}
+
+func TestParseCV_Testdata(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "testdata", "cv.md"))
+	require.NoError(t, err)
+
+	cv := ParseCV(string(content))
+
+	assert.Equal(t, "Ivan Developer", cv.Contact.Name)
+	assert.Contains(t, cv.Summary, "5+ years")
+	require.Len(t, cv.Experience, 1)
+	assert.Equal(t, "TechCorp", cv.Experience[0].Company)
+	assert.Equal(t, 2021, cv.Experience[0].Dates.StartYear)
+	assert.Equal(t, []string{"Go", "Kubernetes", "AWS", "PostgreSQL"}, cv.Experience[0].Technologies)
+	require.Len(t, cv.Education, 1)
+
+	jsonStr, err := cv.ToJSON()
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(jsonStr, `"experience"`))
+}
+
+func TestDateRange_Years(t *testing.T) {
+	tests := []struct {
+		text      string
+		startYear int
+		endYear   int
+		current   bool
+		years     int
+	}{
+		{"2016 - 2020", 2016, 2020, false, 4},
+		{"Jan 2021 – Present", 2021, 0, true, 5},
+		{"03.2019 по н.в.", 2019, 0, true, 7},
+		{"since 2022", 2022, 0, true, 4},
+		{"март 2018 - декабрь 2019", 2018, 2019, false, 1},
+	}
+
+	for _, tt := range tests {
+		dr, _, ok := findDateRange(tt.text)
+		require.True(t, ok, tt.text)
+		assert.Equal(t, tt.startYear, dr.StartYear, tt.text)
+		assert.Equal(t, tt.endYear, dr.EndYear, tt.text)
+		assert.Equal(t, tt.current, dr.Current, tt.text)
+		assert.Equal(t, tt.years, dr.Years(2026), tt.text)
+	}
+}
@@ -0,0 +1,104 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DateRange is a period such as "Jan 2019 - Present" found in a CV entry
+type DateRange struct {
+	Start     string `json:"start,omitempty"`
+	End       string `json:"end,omitempty"`
+	StartYear int    `json:"start_year,omitempty"`
+	EndYear   int    `json:"end_year,omitempty"`
+	Current   bool   `json:"current,omitempty"`
+}
+
+const (
+	// datePart matches "2019", "03/2019", "03.2019", "Mar 2019" or "март 2019"
+	datePart = `(?:(?:\d{1,2}[./]\s?)|(?:` + monthPart + `\.?\s+))?(?:19|20)\d{2}`
+	// monthPart matches English and Russian month names and abbreviations
+	monthPart = `(?:jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec|янв|фев|мар|апр|ма[йя]|июн|июл|авг|сен|окт|ноя|дек)[a-zа-яё]*`
+	// currentPart matches open-ended range ends in English and Russian
+	currentPart = `present|current|now|today|ongoing|по\s+настоящее\s+время|настоящее\s+время|наст\.?\s*время|н\.\s?в\.?|сейчас|текущее\s+время`
+)
+
+var (
+	// dateRangePattern matches "2019 - 2021", "Jan 2019 – Present", "03.2019 по н.в."
+	dateRangePattern = regexp.MustCompile(`(?i)(` + datePart + `)\s*(?:-|–|—|to|until|till|по|до)\s*(` + datePart + `|` + currentPart + `)`)
+
+	// singleYearPattern matches a standalone year
+	singleYearPattern = regexp.MustCompile(`\b(?:19|20)\d{2}\b`)
+
+	// sinceDatePattern matches "since 2020" / "с 2020"
+	sinceDatePattern = regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:since|from|с)\s+(` + datePart + `)`)
+)
+
+// findDateRange finds the first date range in text and returns it with its location
+func findDateRange(text string) (DateRange, []int, bool) {
+	if loc := dateRangePattern.FindStringSubmatchIndex(text); loc != nil {
+		start := text[loc[2]:loc[3]]
+		end := text[loc[4]:loc[5]]
+		dr := DateRange{
+			Start:     strings.TrimSpace(start),
+			End:       strings.TrimSpace(end),
+			StartYear: extractYear(start),
+			EndYear:   extractYear(end),
+		}
+		if dr.EndYear == 0 {
+			dr.Current = true
+		}
+		return dr, loc[:2], true
+	}
+
+	if loc := sinceDatePattern.FindStringSubmatchIndex(text); loc != nil {
+		start := text[loc[2]:loc[3]]
+		return DateRange{
+			Start:     strings.TrimSpace(start),
+			StartYear: extractYear(start),
+			Current:   true,
+		}, loc[:2], true
+	}
+
+	return DateRange{}, nil, false
+}
+
+// extractYear returns the first four-digit year in text, or 0
+func extractYear(text string) int {
+	match := singleYearPattern.FindString(text)
+	if match == "" {
+		return 0
+	}
+	year, err := strconv.Atoi(match)
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// Years returns the span of the range in whole years, using currentYear for open ranges
+func (d DateRange) Years(currentYear int) int {
+	if d.StartYear == 0 {
+		return 0
+	}
+	end := d.EndYear
+	if d.Current || end == 0 {
+		end = currentYear
+	}
+	if end < d.StartYear {
+		return 0
+	}
+	return end - d.StartYear
+}
+
+// LastYear returns the last year the range covers, using currentYear for open ranges
+func (d DateRange) LastYear(currentYear int) int {
+	if d.Current {
+		return currentYear
+	}
+	if d.EndYear != 0 {
+		return d.EndYear
+	}
+	return d.StartYear
+}
@@ -0,0 +1,213 @@
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Job description section kinds
+const (
+	SectionResponsibilities SectionKind = "responsibilities"
+	SectionRequired         SectionKind = "required_qualifications"
+	SectionPreferred        SectionKind = "preferred_qualifications"
+	SectionBenefits         SectionKind = "benefits"
+	SectionCompany          SectionKind = "company"
+)
+
+// JobDescription is the typed model extracted from a stored job description
+type JobDescription struct {
+	Title                   string    `json:"title,omitempty"`
+	Company                 string    `json:"company,omitempty"`
+	Location                string    `json:"location,omitempty"`
+	EmploymentType          string    `json:"employment_type,omitempty"`
+	SalaryRange             string    `json:"salary_range,omitempty"`
+	Summary                 string    `json:"summary,omitempty"`
+	Responsibilities        []string  `json:"responsibilities"`
+	RequiredQualifications  []string  `json:"required_qualifications"`
+	PreferredQualifications []string  `json:"preferred_qualifications"`
+	Benefits                []string  `json:"benefits"`
+	Sections                []Section `json:"sections"`
+}
+
+// jdSectionKeywords maps JD heading keywords (English and Russian) to section kinds.
+// Preferred must come before required: "Preferred qualifications" contains "qualifications".
+// "Bonus" and "plus" only count as phrases, so "Bonus & Perks" or "Plus benefits" stay benefits.
+var jdSectionKeywords = []headingRule{
+	{SectionPreferred, []string{"nice to have", "nice-to-have", "preferred", "bonus points", "a plus", "pluses", "desirable", "desired", "would be great", "желательн", "будет плюсом", "плюсом", "преимуществ"}},
+	{SectionRequired, []string{"requirement", "qualifications", "must have", "must-have", "what we're looking for", "what we are looking for", "what you need", "what you'll need", "what you bring", "you have", "who you are", "требования", "требуется", "мы ожидаем", "ожидания", "необходим", "что нужно знать"}},
+	{SectionResponsibilities, []string{"responsibilit", "what you'll do", "what you will do", "duties", "your role", "your tasks", "key tasks", "обязанности", "задачи", "чем предстоит заниматься", "что делать", "чем заниматься"}},
+	{SectionBenefits, []string{"benefit", "we offer", "perks", "what we offer", "compensation", "why join", "условия", "мы предлагаем", "предлагаем", "что мы предлагаем", "льготы", "бонусы"}},
+	{SectionCompany, []string{"about us", "about the company", "who we are", "company", "о компании", "о нас", "кто мы"}},
+	{SectionSummary, []string{"overview", "about the role", "about the job", "description", "summary", "обзор", "о роли", "о вакансии", "описание"}},
+}
+
+var (
+	// titlePrefixPattern strips "Position:" or "Должность:" prefixes from titles
+	titlePrefixPattern = regexp.MustCompile(`(?i)^(?:job title|position|role|vacancy|вакансия|должность|позиция)\s*[:：]\s*`)
+
+	companyFieldPattern  = fieldPattern(`company|employer|компания|работодатель`)
+	locationFieldPattern = fieldPattern(`location|city|office|локация|город|местоположение|офис`)
+	typeFieldPattern     = fieldPattern(`employment type|employment|job type|type|тип занятости|занятость|формат работы`)
+	salaryFieldPattern   = fieldPattern(`salary|salary range|compensation|pay|зарплата|заработная плата|зп|доход|оклад`)
+
+	// employmentTypePattern finds employment types anywhere in the text
+	employmentTypePattern = regexp.MustCompile(`(?i)\b(full[- ]time|part[- ]time|contract(?:or)?|freelance|internship|temporary|permanent)\b|(полная занятость|частичная занятость|проектная работа|стажировка|временная работа)`)
+
+	// salaryTextPattern finds salary ranges with a currency anywhere in the text
+	salaryTextPattern = regexp.MustCompile(`(?i)(?:[$€£₽]\s?\d[\d\s,.]*[kк]?(?:\s*(?:-|–|—|to|до)\s*[$€£₽]?\s?\d[\d\s,.]*[kк]?)?|\d[\d\s,.]*[kк]?(?:\s*(?:-|–|—|to|до)\s*\d[\d\s,.]*[kк]?)?\s*(?:usd|eur|gbp|rub|руб\.?|рублей|₽|€|\$))(?:\s*(?:per|a|/|в)\s*(?:year|month|hour|annum|год|месяц|час))?`)
+)
+
+// fieldPattern builds a matcher for "Label: value" lines
+func fieldPattern(labels string) *regexp.Regexp {
+	return regexp.MustCompile(`(?im)^\s*(?:[-*]\s*)?(?:\*\*)?(?:` + labels + `)(?:\*\*)?\s*[:：]\s*(?:\*\*)?\s*(.+)$`)
+}
+
+// ParseJD parses job description markdown into a typed model
+func ParseJD(content string) *JobDescription {
+	title, sections := splitSections(content, jdSectionKeywords)
+
+	jd := &JobDescription{
+		Responsibilities:        []string{},
+		RequiredQualifications:  []string{},
+		PreferredQualifications: []string{},
+		Benefits:                []string{},
+		Sections:                sections,
+	}
+
+	// Titles look like "Senior Engineer", "Senior Engineer - Acme" or "Должность: Инженер"
+	title = titlePrefixPattern.ReplaceAllString(strings.TrimSpace(title), "")
+	if parts := titleSplitPattern.Split(title, 2); len(parts) == 2 {
+		jd.Title, jd.Company = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	} else {
+		jd.Title = title
+	}
+
+	for _, section := range sections {
+		switch section.Kind {
+		case SectionResponsibilities:
+			jd.Responsibilities = append(jd.Responsibilities, listItems(section.Content)...)
+		case SectionRequired:
+			jd.RequiredQualifications = append(jd.RequiredQualifications, listItems(section.Content)...)
+		case SectionPreferred:
+			jd.PreferredQualifications = append(jd.PreferredQualifications, listItems(section.Content)...)
+		case SectionBenefits:
+			jd.Benefits = append(jd.Benefits, listItems(section.Content)...)
+		case SectionSummary, SectionHeader:
+			jd.Summary = joinNonEmpty(jd.Summary, fieldlessText(section.Content))
+		}
+	}
+
+	jd.fillFields(content)
+
+	return jd
+}
+
+// fillFields extracts labelled fields, falling back to free-text heuristics
+func (jd *JobDescription) fillFields(content string) {
+	if m := companyFieldPattern.FindStringSubmatch(content); m != nil {
+		jd.Company = cleanField(m[1])
+	}
+	if m := locationFieldPattern.FindStringSubmatch(content); m != nil {
+		jd.Location = cleanField(m[1])
+	}
+	if m := typeFieldPattern.FindStringSubmatch(content); m != nil {
+		jd.EmploymentType = cleanField(m[1])
+	} else if m := employmentTypePattern.FindString(content); m != "" {
+		jd.EmploymentType = strings.ToLower(m)
+	}
+	if m := salaryFieldPattern.FindStringSubmatch(content); m != nil {
+		jd.SalaryRange = cleanField(m[1])
+	} else if m := salaryTextPattern.FindString(content); m != "" {
+		jd.SalaryRange = strings.TrimSpace(m)
+	}
+}
+
+// cleanField removes markdown emphasis and trailing punctuation from a field value
+func cleanField(value string) string {
+	value = strings.ReplaceAll(value, "**", "")
+	return strings.TrimRight(strings.TrimSpace(value), ",;. ")
+}
+
+// isFieldLine reports whether a line is one of the labelled header fields
+func isFieldLine(line string) bool {
+	for _, p := range []*regexp.Regexp{companyFieldPattern, locationFieldPattern, typeFieldPattern, salaryFieldPattern} {
+		if p.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldlessText returns section text without labelled field lines
+func fieldlessText(content string) string {
+	var lines []string
+	for _, line := range contentLines(content) {
+		if !isFieldLine(line) {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// listItems returns section lines with list markers removed
+func listItems(content string) []string {
+	var items []string
+	for _, line := range contentLines(content) {
+		if item := stripBullet(line); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// IsStructured reports whether any requirement-bearing section was recognized
+func (jd *JobDescription) IsStructured() bool {
+	return len(jd.Responsibilities) > 0 || len(jd.RequiredQualifications) > 0 || len(jd.PreferredQualifications) > 0
+}
+
+// CoreText returns the title, summary, responsibilities, required qualifications
+// and any sections the parser could not classify
+func (jd *JobDescription) CoreText() string {
+	parts := []string{jd.Title, jd.Summary}
+	parts = append(parts, jd.Responsibilities...)
+	parts = append(parts, jd.RequiredQualifications...)
+	for _, section := range jd.Sections {
+		if section.Kind == SectionUnknown {
+			parts = append(parts, section.Heading, section.Content)
+		}
+	}
+	return strings.TrimSpace(strings.Join(nonEmpty(parts), "\n"))
+}
+
+// PreferredText returns the preferred (nice-to-have) qualifications
+func (jd *JobDescription) PreferredText() string {
+	return strings.Join(jd.PreferredQualifications, "\n")
+}
+
+// ScoringText returns the parts of the JD that describe the job itself.
+// Benefits and company boilerplate are left out so they don't dilute term matching.
+func (jd *JobDescription) ScoringText() string {
+	return strings.TrimSpace(strings.Join(nonEmpty([]string{jd.CoreText(), jd.PreferredText()}), "\n"))
+}
+
+// nonEmpty filters out blank strings
+func nonEmpty(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// ToJSON converts the job description model to JSON
+func (jd *JobDescription) ToJSON() (string, error) {
+	data, err := json.MarshalIndent(jd, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal job description: %w", err)
+	}
+	return string(data), nil
+}
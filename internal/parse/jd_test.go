@@ -0,0 +1,100 @@
+package parse
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleJD = `# Senior Backend Engineer - Acme Corp
+
+Location: Remote (EU)
+Employment Type: Full-time
+Salary: $120k - $150k per year
+
+## About the role
+Join the platform team building our payments infrastructure.
+
+## Responsibilities
+- Design and build Go microservices
+- Own production reliability
+
+## Requirements
+- 5+ years of backend development
+- Strong Kubernetes knowledge
+
+## Nice to have
+- Experience with Kafka
+
+## What we offer
+- Unlimited vacation
+- Home office budget
+`
+
+func TestParseJD_Markdown(t *testing.T) {
+	jd := ParseJD(sampleJD)
+
+	assert.Equal(t, "Senior Backend Engineer", jd.Title)
+	assert.Equal(t, "Acme Corp", jd.Company)
+	assert.Equal(t, "Remote (EU)", jd.Location)
+	assert.Equal(t, "Full-time", jd.EmploymentType)
+	assert.Equal(t, "$120k - $150k per year", jd.SalaryRange)
+	assert.Equal(t, "Join the platform team building our payments infrastructure.", jd.Summary)
+
+	assert.Equal(t, []string{"Design and build Go microservices", "Own production reliability"}, jd.Responsibilities)
+	assert.Equal(t, []string{"5+ years of backend development", "Strong Kubernetes knowledge"}, jd.RequiredQualifications)
+	assert.Equal(t, []string{"Experience with Kafka"}, jd.PreferredQualifications)
+	assert.Equal(t, []string{"Unlimited vacation", "Home office budget"}, jd.Benefits)
+	assert.True(t, jd.IsStructured())
+
+	scoring := jd.ScoringText()
+	assert.Contains(t, scoring, "Kubernetes")
+	assert.Contains(t, scoring, "Kafka")
+	assert.NotContains(t, scoring, "vacation")
+	assert.NotContains(t, jd.CoreText(), "Kafka")
+}
+
+func TestParseJD_BenefitHeadings(t *testing.T) {
+	for _, heading := range []string{"Bonus & Perks", "Plus benefits"} {
+		jd := ParseJD("# Backend Engineer\n\n## Requirements\n- Go\n\n## " + heading + "\n- Annual bonus\n- Gym membership\n")
+
+		assert.Equal(t, []string{"Annual bonus", "Gym membership"}, jd.Benefits, heading)
+		assert.Empty(t, jd.PreferredQualifications, heading)
+		assert.NotContains(t, jd.ScoringText(), "Gym", heading)
+	}
+
+	for _, heading := range []string{"Bonus points", "Would be a plus", "Pluses"} {
+		jd := ParseJD("# Backend Engineer\n\n## " + heading + "\n- Kafka\n")
+		assert.Equal(t, []string{"Kafka"}, jd.PreferredQualifications, heading)
+	}
+}
+
+func TestParseJD_Russian(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "testdata", "job_ru.md"))
+	require.NoError(t, err)
+
+	jd := ParseJD(string(content))
+
+	assert.Equal(t, "Старший инженер-программист", jd.Title)
+	assert.Contains(t, jd.Summary, "распределённых систем")
+	require.Len(t, jd.RequiredQualifications, 5)
+	assert.Equal(t, "Более 5 лет опыта разработки программного обеспечения", jd.RequiredQualifications[0])
+	require.Len(t, jd.Responsibilities, 5)
+	assert.Equal(t, "Наставлять младших разработчиков", jd.Responsibilities[2])
+	require.Len(t, jd.PreferredQualifications, 4)
+	assert.Equal(t, "PostgreSQL и Redis", jd.PreferredQualifications[3])
+}
+
+func TestParseJD_Unstructured(t *testing.T) {
+	jd := ParseJD("golang java typescript")
+
+	assert.False(t, jd.IsStructured())
+	assert.Equal(t, "golang java typescript", jd.ScoringText())
+
+	jsonStr, err := jd.ToJSON()
+	require.NoError(t, err)
+	assert.Contains(t, jsonStr, `"required_qualifications": []`)
+}
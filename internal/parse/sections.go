@@ -0,0 +1,206 @@
+// Package parse turns stored CV and job description markdown into typed models.
+package parse
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// SectionKind identifies the semantic role of a document section
+type SectionKind string
+
+const (
+	SectionUnknown        SectionKind = "unknown"
+	SectionHeader         SectionKind = "header"
+	SectionContact        SectionKind = "contact"
+	SectionSummary        SectionKind = "summary"
+	SectionExperience     SectionKind = "experience"
+	SectionEducation      SectionKind = "education"
+	SectionSkills         SectionKind = "skills"
+	SectionLanguages      SectionKind = "languages"
+	SectionProjects       SectionKind = "projects"
+	SectionCertifications SectionKind = "certifications"
+)
+
+// Section is a titled block of a document
+type Section struct {
+	Kind    SectionKind `json:"kind"`
+	Heading string      `json:"heading"`
+	Content string      `json:"content"`
+}
+
+// headingRule maps heading keywords to a section kind
+type headingRule struct {
+	kind     SectionKind
+	keywords []string
+}
+
+// cvSectionKeywords maps CV heading keywords (English and Russian) to section kinds.
+// Order matters: the first matching kind wins.
+var cvSectionKeywords = []headingRule{
+	// Skill headings that contain a later kind's keyword ("language", "qualifications")
+	{SectionSkills, []string{"programming language", "key qualification", "core qualification", "языки программирования"}},
+	{SectionContact, []string{"contact", "personal info", "personal details", "контакт", "личная информация"}},
+	{SectionSummary, []string{"summary", "profile", "about", "objective", "overview", "о себе", "обо мне", "профиль", "цель"}},
+	{SectionExperience, []string{"experience", "employment", "work history", "career", "professional background", "опыт", "места работы", "трудовая"}},
+	{SectionEducation, []string{"education", "academic", "qualifications", "образование", "учеба", "учёба"}},
+	{SectionCertifications, []string{"certification", "certificate", "licenses", "courses", "training", "сертификат", "курсы"}},
+	{SectionLanguages, []string{"language", "языки", "знание языков", "иностранные"}},
+	{SectionProjects, []string{"project", "portfolio", "open source", "проект", "портфолио"}},
+	{SectionSkills, []string{"skill", "technologies", "tech stack", "technical", "tools", "competenc", "expertise", "навык", "технологи", "стек", "компетенц"}},
+}
+
+var (
+	// markdownHeadingPattern matches "## Heading" lines
+	markdownHeadingPattern = regexp.MustCompile(`^\s{0,3}(#{1,6})\s+(.+?)\s*#*\s*$`)
+
+	// boldHeadingPattern matches "**Heading**" lines used by some converters
+	boldHeadingPattern = regexp.MustCompile(`^\s*\*\*([^*]+)\*\*:?\s*$`)
+
+	// bulletPattern strips list markers
+	bulletPattern = regexp.MustCompile(`^\s*(?:[-*•●▪‣◦]|\d+[.)])\s+`)
+
+	// listSeparatorPattern splits inline lists such as "Go, Python; SQL"
+	listSeparatorPattern = regexp.MustCompile(`\s*[,;|•·]\s*`)
+)
+
+// classifyHeading maps a heading text to a section kind using keyword tables
+func classifyHeading(heading string, table []headingRule) SectionKind {
+	lower := strings.ToLower(heading)
+	for _, entry := range table {
+		for _, keyword := range entry.keywords {
+			if strings.Contains(lower, keyword) {
+				return entry.kind
+			}
+		}
+	}
+	return SectionUnknown
+}
+
+// splitSections splits content into sections using markdown headings, falling back
+// to heuristics for plain text extracted from PDFs (short upper-case or colon-terminated
+// lines that match a known section keyword). Text before the first heading becomes a
+// header section; the first level-one heading is kept as the header title.
+func splitSections(content string, table []headingRule) (title string, sections []Section) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	current := Section{Kind: SectionHeader}
+	var body []string
+
+	flush := func() {
+		current.Content = strings.TrimSpace(strings.Join(body, "\n"))
+		if current.Content != "" || current.Heading != "" {
+			sections = append(sections, current)
+		}
+		body = nil
+	}
+
+	for _, line := range lines {
+		heading, level, ok := detectHeading(line, table)
+		if !ok {
+			body = append(body, line)
+			continue
+		}
+
+		// The first level-one heading is the document title (e.g. candidate name or job title)
+		if level == 1 && title == "" {
+			title = heading
+			continue
+		}
+
+		// Sub-headings inside a known section stay part of that section (e.g. "### Company")
+		kind := classifyHeading(heading, table)
+		if level > 2 && kind == SectionUnknown && current.Kind != SectionHeader {
+			body = append(body, line)
+			continue
+		}
+
+		flush()
+		current = Section{Kind: kind, Heading: heading}
+	}
+	flush()
+
+	return title, sections
+}
+
+// detectHeading reports whether a line is a section heading and returns its text and level.
+// Plain-text headings only count when they match a known section keyword.
+func detectHeading(line string, table []headingRule) (string, int, bool) {
+	if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+		return strings.TrimSpace(m[2]), len(m[1]), true
+	}
+
+	trimmed := strings.TrimSpace(line)
+	if m := boldHeadingPattern.FindStringSubmatch(trimmed); m != nil {
+		heading := strings.TrimSpace(m[1])
+		if classifyHeading(heading, table) != SectionUnknown {
+			return heading, 2, true
+		}
+		return "", 0, false
+	}
+
+	// PDF heuristics: short line, no sentence punctuation, upper-case or ending with a colon
+	if trimmed == "" || len([]rune(trimmed)) > 40 || bulletPattern.MatchString(trimmed) {
+		return "", 0, false
+	}
+	candidate := strings.TrimSuffix(trimmed, ":")
+	if strings.ContainsAny(candidate, ".,;@|()") {
+		return "", 0, false
+	}
+	if !strings.HasSuffix(trimmed, ":") && !isUpperText(candidate) {
+		return "", 0, false
+	}
+	if classifyHeading(candidate, table) == SectionUnknown {
+		return "", 0, false
+	}
+	return candidate, 2, true
+}
+
+// isUpperText reports whether all letters in text are upper-case
+func isUpperText(text string) bool {
+	hasLetter := false
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+			if !unicode.IsUpper(r) {
+				return false
+			}
+		}
+	}
+	return hasLetter
+}
+
+// contentLines returns the non-empty lines of a section body
+func contentLines(content string) []string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// stripBullet removes list markers and surrounding whitespace
+func stripBullet(line string) string {
+	return strings.TrimSpace(bulletPattern.ReplaceAllString(line, ""))
+}
+
+// isBullet reports whether a line is a list item
+func isBullet(line string) bool {
+	return bulletPattern.MatchString(line)
+}
+
+// splitList splits comma/semicolon/pipe separated values, also unwrapping brackets
+func splitList(text string) []string {
+	text = strings.Trim(strings.TrimSpace(text), "[]")
+	var items []string
+	for _, item := range listSeparatorPattern.Split(text, -1) {
+		item = strings.Trim(strings.TrimSpace(item), "*[]")
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
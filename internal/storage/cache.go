@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cacheDirName is the storage subdirectory holding cached analysis results
+const cacheDirName = "cache"
+
+// cacheEntryName returns the file name of a cached analysis: "<cv id>_<jd id>_<key>.json".
+// Document IDs are content hashes, so an entry never outlives a change to either document.
+func cacheEntryName(cvID, jdID, key string) string {
+	return fmt.Sprintf("%s_%s_%s.json", cvID, jdID, key)
+}
+
+// cacheIDs returns the CV and JD IDs an entry was computed from
+func cacheIDs(name string) (cvID, jdID string, ok bool) {
+	parts := strings.Split(strings.TrimSuffix(name, filepath.Ext(name)), "_")
+	if len(parts) != 3 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// analysisIDs returns the IDs of a CV and JD URI pair
+func analysisIDs(cvURI, jdURI string) (cvID, jdID string, err error) {
+	if _, cvID, err = ParseURI(cvURI); err != nil {
+		return "", "", err
+	}
+	if _, jdID, err = ParseURI(jdURI); err != nil {
+		return "", "", err
+	}
+	return cvID, jdID, nil
+}
+
+// ReadCachedAnalysis returns the cached analysis of a CV/JD pair under key, which callers
+// derive from everything else the result depends on (engine version, dictionary, options)
+func (sm *StorageManager) ReadCachedAnalysis(cvURI, jdURI, key string) ([]byte, bool) {
+	cvID, jdID, err := analysisIDs(cvURI, jdURI)
+	if err != nil {
+		return nil, false
+	}
+	data, err := sm.fs.ReadFile(filepath.Join(sm.basePath, cacheDirName, cacheEntryName(cvID, jdID, key)))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// WriteCachedAnalysis stores the analysis of a CV/JD pair under key
+func (sm *StorageManager) WriteCachedAnalysis(cvURI, jdURI, key string, data []byte) error {
+	cvID, jdID, err := analysisIDs(cvURI, jdURI)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(sm.basePath, cacheDirName)
+	if err := sm.fs.MkdirAll(dir, 0755); err != nil {
+		return &StorageError{Operation: "create cache directory", Path: dir, Err: err}
+	}
+	path := filepath.Join(dir, cacheEntryName(cvID, jdID, key))
+	if err := sm.fs.WriteFile(path, data, 0644); err != nil {
+		return &StorageError{Operation: "write cached analysis", Path: path, Err: err}
+	}
+	return nil
+}
+
+// pruneAnalysisCache removes cached analyses older than cutoff or computed from a document
+// that is no longer stored. Entries from older engine versions expire with the cutoff.
+func (sm *StorageManager) pruneAnalysisCache(ctx context.Context, cutoff time.Time) int64 {
+	dir := filepath.Join(sm.basePath, cacheDirName)
+	entries, err := sm.fs.ReadDir(dir)
+	if err != nil {
+		// No analysis has been cached yet
+		return 0
+	}
+
+	cvIDs, jdIDs, err := sm.ListAllDocuments()
+	if err != nil {
+		return 0
+	}
+	stored := make(map[string]bool, len(cvIDs)+len(jdIDs))
+	for _, id := range append(cvIDs, jdIDs...) {
+		stored[id] = true
+	}
+
+	var removed int64
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		cvID, jdID, ok := cacheIDs(entry.Name())
+		if !ok || info.ModTime().Before(cutoff) || !stored[cvID] || !stored[jdID] {
+			if err := sm.fs.Remove(filepath.Join(dir, entry.Name())); err == nil {
+				removed++
+			}
+		}
+	}
+
+	sm.logger.DebugContext(ctx, "analysis cache pruned", "removed", removed)
+	return removed
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageManager_AnalysisCache(t *testing.T) {
+	fs := NewMemMapFileSystem()
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		DefaultTTL: time.Hour,
+		FileSystem: fs,
+	})
+	require.NoError(t, err)
+
+	cvURI, err := sm.SaveDocument(DocumentTypeCV, []byte("Test CV"), "cv.md")
+	require.NoError(t, err)
+	jdURI, err := sm.SaveDocument(DocumentTypeJD, []byte("Test JD"), "jd.md")
+	require.NoError(t, err)
+	otherURI, err := sm.SaveDocument(DocumentTypeJD, []byte("Other JD"), "other.md")
+	require.NoError(t, err)
+
+	_, ok := sm.ReadCachedAnalysis(cvURI, jdURI, "k1")
+	assert.False(t, ok, "nothing cached yet")
+
+	require.NoError(t, sm.WriteCachedAnalysis(cvURI, jdURI, "k1", []byte(`{"score":1}`)))
+	require.NoError(t, sm.WriteCachedAnalysis(cvURI, otherURI, "k1", []byte(`{"score":2}`)))
+
+	data, ok := sm.ReadCachedAnalysis(cvURI, jdURI, "k1")
+	require.True(t, ok)
+	assert.JSONEq(t, `{"score":1}`, string(data))
+
+	_, ok = sm.ReadCachedAnalysis(cvURI, jdURI, "k2")
+	assert.False(t, ok, "another key is another engine version or option set")
+
+	t.Run("cleanup drops entries of removed documents", func(t *testing.T) {
+		path, err := sm.GetDocumentPath(jdURI)
+		require.NoError(t, err)
+		require.NoError(t, fs.Remove(path))
+
+		_, err = sm.Cleanup(time.Hour)
+		require.NoError(t, err)
+
+		_, ok := sm.ReadCachedAnalysis(cvURI, jdURI, "k1")
+		assert.False(t, ok)
+		_, ok = sm.ReadCachedAnalysis(cvURI, otherURI, "k1")
+		assert.True(t, ok, "entries of stored documents are kept")
+
+		cvCount, jdCount, err := sm.GetStorageStats()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cvCount)
+		assert.Equal(t, int64(1), jdCount)
+	})
+
+	t.Run("invalid URIs are not cached", func(t *testing.T) {
+		assert.Error(t, sm.WriteCachedAnalysis("bad", jdURI, "k1", []byte("{}")))
+		_, ok := sm.ReadCachedAnalysis("bad", jdURI, "k1")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DefaultDuplicateThreshold is the SimHash similarity above which documents count as near-duplicates
+const DefaultDuplicateThreshold = 0.9
+
+// shingleSize is the number of words per SimHash feature
+const shingleSize = 2
+
+// SimilarDocument is a stored document that is nearly identical to another one
+type SimilarDocument struct {
+	URI        string  `json:"uri"`
+	Similarity float64 `json:"similarity"`
+}
+
+// DuplicateCluster is a group of near-identical documents of the same type
+type DuplicateCluster struct {
+	Type          DocumentType `json:"type"`
+	URIs          []string     `json:"uris"`
+	MinSimilarity float64      `json:"min_similarity"`
+}
+
+// Fingerprint computes a 64-bit SimHash of the document text.
+// Text is lowercased and split into words so whitespace, punctuation and
+// re-exported formatting don't change the fingerprint; word shingles keep word order significant.
+func Fingerprint(content []byte) uint64 {
+	words := strings.FieldsFunc(strings.ToLower(string(content)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	addFeature := func(feature string) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	if len(words) < shingleSize {
+		addFeature(strings.Join(words, " "))
+	}
+	for i := 0; i+shingleSize <= len(words); i++ {
+		addFeature(strings.Join(words[i:i+shingleSize], " "))
+	}
+
+	var fingerprint uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			fingerprint |= 1 << uint(bit)
+		}
+	}
+	return fingerprint
+}
+
+// Similarity returns the share of equal bits in two fingerprints (1.0 means identical)
+func Similarity(a, b uint64) float64 {
+	return 1 - float64(bits.OnesCount64(a^b))/64
+}
+
+// formatFingerprint encodes a fingerprint for frontmatter
+func formatFingerprint(fingerprint uint64) string {
+	return fmt.Sprintf("%016x", fingerprint)
+}
+
+// readFingerprint returns the SimHash stored in a document's frontmatter.
+// Documents saved before fingerprints existed are fingerprinted from their body.
+func (sm *StorageManager) readFingerprint(path string) (uint64, error) {
+	data, err := sm.fs.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	body := data
+	if bytes.HasPrefix(data, []byte("---\n")) {
+		if end := bytes.Index(data[4:], []byte("\n---\n")); end >= 0 {
+			scanner := bufio.NewScanner(bytes.NewReader(data[4 : 4+end]))
+			for scanner.Scan() {
+				if value, ok := strings.CutPrefix(scanner.Text(), "simhash: "); ok {
+					if fingerprint, parseErr := strconv.ParseUint(strings.TrimSpace(value), 16, 64); parseErr == nil {
+						return fingerprint, nil
+					}
+				}
+			}
+			body = data[4+end+5:]
+		}
+	}
+	return Fingerprint(body), nil
+}
+
+// fingerprints returns the fingerprints of all documents of a type keyed by URI
+func (sm *StorageManager) fingerprints(docType DocumentType) (map[string]uint64, error) {
+	dir := sm.GetPath(docType)
+	entries, err := sm.fs.ReadDir(dir)
+	if err != nil {
+		return nil, &StorageError{
+			Operation: "read directory",
+			Path:      dir,
+			Err:       err,
+		}
+	}
+
+	result := make(map[string]uint64, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := filepath.Ext(name)
+		if entry.IsDir() || ext == "" {
+			continue
+		}
+		fingerprint, err := sm.readFingerprint(filepath.Join(dir, name))
+		if err != nil {
+			sm.logger.Debug("failed to read document fingerprint", "error", err, "file", name)
+			continue
+		}
+		result[fmt.Sprintf("%s://%s", docType, name[:len(name)-len(ext)])] = fingerprint
+	}
+	return result, nil
+}
+
+// FindNearDuplicates returns stored documents whose SimHash similarity to uri is at least threshold,
+// most similar first
+func (sm *StorageManager) FindNearDuplicates(uri string, threshold float64) ([]SimilarDocument, error) {
+	docType, _, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sm.GetDocumentPath(uri)
+	if err != nil {
+		return nil, err
+	}
+	target, err := sm.readFingerprint(path)
+	if err != nil {
+		return nil, &StorageError{Operation: "read fingerprint", Path: path, Err: err}
+	}
+
+	all, err := sm.fingerprints(docType)
+	if err != nil {
+		return nil, err
+	}
+
+	var similar []SimilarDocument
+	for other, fingerprint := range all {
+		if other == uri {
+			continue
+		}
+		if score := Similarity(target, fingerprint); score >= threshold {
+			similar = append(similar, SimilarDocument{URI: other, Similarity: score})
+		}
+	}
+	sort.Slice(similar, func(i, j int) bool {
+		if similar[i].Similarity != similar[j].Similarity {
+			return similar[i].Similarity > similar[j].Similarity
+		}
+		return similar[i].URI < similar[j].URI
+	})
+	return similar, nil
+}
+
+// FindDuplicateClusters groups stored documents of each type whose SimHash similarity
+// is at least threshold. Clusters are connected components, so A~B and B~C put A, B and C together.
+func (sm *StorageManager) FindDuplicateClusters(threshold float64) ([]DuplicateCluster, error) {
+	var clusters []DuplicateCluster
+
+	for _, docType := range []DocumentType{DocumentTypeCV, DocumentTypeJD} {
+		all, err := sm.fingerprints(docType)
+		if err != nil {
+			return nil, err
+		}
+
+		uris := make([]string, 0, len(all))
+		for uri := range all {
+			uris = append(uris, uri)
+		}
+		sort.Strings(uris)
+
+		// Union-find over all pairs above the threshold
+		parent := make(map[string]string, len(uris))
+		var find func(string) string
+		find = func(u string) string {
+			if parent[u] != u {
+				parent[u] = find(parent[u])
+			}
+			return parent[u]
+		}
+		for _, u := range uris {
+			parent[u] = u
+		}
+		for i := range uris {
+			for j := i + 1; j < len(uris); j++ {
+				if Similarity(all[uris[i]], all[uris[j]]) >= threshold {
+					parent[find(uris[j])] = find(uris[i])
+				}
+			}
+		}
+
+		groups := make(map[string][]string)
+		for _, u := range uris {
+			root := find(u)
+			groups[root] = append(groups[root], u)
+		}
+
+		for _, u := range uris {
+			members, ok := groups[u]
+			if !ok || len(members) < 2 {
+				continue
+			}
+			minSimilarity := 1.0
+			for i := range members {
+				for j := i + 1; j < len(members); j++ {
+					if score := Similarity(all[members[i]], all[members[j]]); score < minSimilarity {
+						minSimilarity = score
+					}
+				}
+			}
+			clusters = append(clusters, DuplicateCluster{Type: docType, URIs: members, MinSimilarity: minSimilarity})
+		}
+	}
+
+	return clusters, nil
+}
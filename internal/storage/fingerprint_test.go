@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fingerprintCV = `# Jane Doe - Senior Backend Engineer
+
+## Summary
+Backend engineer with eight years of experience building distributed systems,
+payment platforms and event driven architectures for high traffic products.
+
+## Experience
+### Senior Software Engineer at Acme Corp (2021 - Present)
+- Led the migration of forty services to Kubernetes
+- Reduced p99 latency by forty percent through caching and query tuning
+- Mentored five engineers and ran the backend guild
+
+### Software Engineer at Initech (2016 - 2020)
+- Built billing services in Java and Go
+- Designed the reconciliation pipeline on Kafka
+
+## Skills
+Go, Java, PostgreSQL, Kafka, Kubernetes, Terraform
+`
+
+func TestFingerprint(t *testing.T) {
+	base := Fingerprint([]byte(fingerprintCV))
+
+	// Whitespace and case changes don't change the fingerprint
+	reformatted := strings.ReplaceAll(strings.ToUpper(fingerprintCV), "\n", "\n\n  ")
+	assert.Equal(t, base, Fingerprint([]byte(reformatted)))
+
+	// A small edit stays above the default threshold
+	edited := strings.Replace(fingerprintCV, "five engineers", "six engineers", 1)
+	assert.GreaterOrEqual(t, Similarity(base, Fingerprint([]byte(edited))), DefaultDuplicateThreshold)
+
+	// An unrelated document does not
+	other := Fingerprint([]byte("# Job Description\n\nWe are hiring a frontend developer with React, TypeScript and CSS skills to build our design system."))
+	assert.Less(t, Similarity(base, other), DefaultDuplicateThreshold)
+
+	assert.Equal(t, uint64(0), Fingerprint(nil))
+	assert.Equal(t, 1.0, Similarity(base, base))
+}
+
+func TestStorageManager_FindNearDuplicates(t *testing.T) {
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		FileSystem: NewMemMapFileSystem(),
+	})
+	require.NoError(t, err)
+
+	original, err := sm.SaveDocument(DocumentTypeCV, []byte(fingerprintCV), "cv.md")
+	require.NoError(t, err)
+	reexported, err := sm.SaveDocument(DocumentTypeCV, []byte(strings.ReplaceAll(fingerprintCV, "\n", "\r\n")), "cv.pdf")
+	require.NoError(t, err)
+	require.NotEqual(t, original, reexported, "content hash differs for re-exported document")
+	_, err = sm.SaveDocument(DocumentTypeCV, []byte("# John Smith\n\nFrontend developer working with React and TypeScript on design systems."), "other.md")
+	require.NoError(t, err)
+
+	// The same text stored as a JD is not compared with CVs
+	_, err = sm.SaveDocument(DocumentTypeJD, []byte(fingerprintCV), "jd.md")
+	require.NoError(t, err)
+
+	similar, err := sm.FindNearDuplicates(reexported, DefaultDuplicateThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, []SimilarDocument{{URI: original, Similarity: 1.0}}, similar)
+
+	clusters, err := sm.FindDuplicateClusters(DefaultDuplicateThreshold)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, DocumentTypeCV, clusters[0].Type)
+	assert.ElementsMatch(t, []string{original, reexported}, clusters[0].URIs)
+	assert.Equal(t, 1.0, clusters[0].MinSimilarity)
+}
+
+func TestStorageManager_FingerprintFrontmatter(t *testing.T) {
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		FileSystem: NewMemMapFileSystem(),
+	})
+	require.NoError(t, err)
+
+	uri, err := sm.SaveDocument(DocumentTypeCV, []byte(fingerprintCV), "cv.md")
+	require.NoError(t, err)
+
+	content, err := sm.ReadDocument(uri)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "simhash: "+formatFingerprint(Fingerprint([]byte(fingerprintCV))))
+
+	// Documents without a stored fingerprint are fingerprinted from their body
+	path, err := sm.GetDocumentPath(uri)
+	require.NoError(t, err)
+	require.NoError(t, sm.fs.WriteFile(path, []byte("---\nid: legacy\ntype: cv\n---\n"+fingerprintCV), 0644))
+	fingerprint, err := sm.readFingerprint(path)
+	require.NoError(t, err)
+	assert.Equal(t, Fingerprint([]byte(fingerprintCV)), fingerprint)
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AnalysisScheme is the URI scheme of stored analysis records (analysis://{id})
+const AnalysisScheme = "analysis://"
+
+// analysesDirName is the storage subdirectory holding analysis records
+const analysesDirName = "analyses"
+
+// NewAnalysisID returns an ID for an analysis of a CV/JD pair run at a given time. IDs start
+// with the UTC timestamp, so sorting them orders records by time.
+func NewAnalysisID(cvURI, jdURI string, at time.Time) string {
+	hash := GenerateIDFromString(fmt.Sprintf("%s|%s|%d", cvURI, jdURI, at.UnixNano()))
+	return at.UTC().Format("20060102T150405Z") + "-" + hash[:12]
+}
+
+// ParseAnalysisURI returns the ID of an analysis:// URI
+func ParseAnalysisURI(uri string) (string, error) {
+	id, ok := strings.CutPrefix(uri, AnalysisScheme)
+	if !ok || id == "" || strings.ContainsAny(id, `/\.`) {
+		return "", &StorageError{
+			Operation: "parse URI",
+			Err:       fmt.Errorf("invalid analysis URI: %s", uri),
+		}
+	}
+	return id, nil
+}
+
+// analysisPath returns the file path of an analysis record
+func (sm *StorageManager) analysisPath(id string) string {
+	return filepath.Join(sm.basePath, analysesDirName, id+".json")
+}
+
+// SaveAnalysis stores an analysis record and returns its URI. Records are kept when their
+// documents are cleaned up, so past results stay reviewable.
+func (sm *StorageManager) SaveAnalysis(id string, data []byte) (string, error) {
+	dir := filepath.Join(sm.basePath, analysesDirName)
+	if err := sm.fs.MkdirAll(dir, 0755); err != nil {
+		return "", &StorageError{Operation: "create analyses directory", Path: dir, Err: err}
+	}
+	path := sm.analysisPath(id)
+	if err := sm.fs.WriteFile(path, data, 0644); err != nil {
+		return "", &StorageError{Operation: "save analysis", Path: path, Err: err}
+	}
+
+	sm.logger.DebugContext(context.Background(), "analysis saved", "id", id, "path", path)
+	return AnalysisScheme + id, nil
+}
+
+// ReadAnalysis reads an analysis record by URI
+func (sm *StorageManager) ReadAnalysis(uri string) ([]byte, error) {
+	id, err := ParseAnalysisURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	path := sm.analysisPath(id)
+	data, err := sm.fs.ReadFile(path)
+	if err != nil {
+		return nil, &StorageError{Operation: "read analysis", Path: path, Err: err}
+	}
+	return data, nil
+}
+
+// ListAnalyses returns the URIs of all analysis records, newest first
+func (sm *StorageManager) ListAnalyses() ([]string, error) {
+	dir := filepath.Join(sm.basePath, analysesDirName)
+	entries, err := sm.fs.ReadDir(dir)
+	if err != nil {
+		if _, statErr := sm.fs.Stat(dir); statErr != nil {
+			// No analysis has been saved yet
+			return nil, nil
+		}
+		return nil, &StorageError{Operation: "list analyses", Path: dir, Err: err}
+	}
+
+	var uris []string
+	for _, entry := range entries {
+		if id, ok := strings.CutSuffix(entry.Name(), ".json"); ok && !entry.IsDir() {
+			uris = append(uris, AnalysisScheme+id)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(uris)))
+	return uris, nil
+}
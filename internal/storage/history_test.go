@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageManager_AnalysisHistory(t *testing.T) {
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		DefaultTTL: time.Hour,
+		FileSystem: NewMemMapFileSystem(),
+	})
+	require.NoError(t, err)
+
+	uris, err := sm.ListAnalyses()
+	require.NoError(t, err)
+	assert.Empty(t, uris)
+
+	older := NewAnalysisID("cv://a", "jd://b", time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC))
+	newer := NewAnalysisID("cv://a", "jd://b", time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC))
+	assert.NotEqual(t, older, newer)
+
+	olderURI, err := sm.SaveAnalysis(older, []byte(`{"n":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, "analysis://"+older, olderURI)
+	newerURI, err := sm.SaveAnalysis(newer, []byte(`{"n":2}`))
+	require.NoError(t, err)
+
+	uris, err = sm.ListAnalyses()
+	require.NoError(t, err)
+	assert.Equal(t, []string{newerURI, olderURI}, uris)
+
+	data, err := sm.ReadAnalysis(olderURI)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"n":1}`, string(data))
+
+	_, err = sm.ReadAnalysis("analysis://missing")
+	assert.Error(t, err)
+	_, err = sm.ReadAnalysis("analysis://../cv/x")
+	assert.Error(t, err)
+	_, err = sm.ReadAnalysis("cv://" + older)
+	assert.Error(t, err)
+
+	// Records outlive their documents
+	_, err = sm.Cleanup(time.Nanosecond)
+	require.NoError(t, err)
+	_, err = sm.ReadAnalysis(olderURI)
+	assert.NoError(t, err)
+}
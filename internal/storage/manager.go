@@ -162,8 +162,9 @@ id: %s
 original_filename: %s
 ingested_at: %s
 type: %s
+simhash: %s
 ---
-`, id, originalFilename, time.Now().UTC().Format(time.RFC3339), docType)
+`, id, originalFilename, time.Now().UTC().Format(time.RFC3339), docType, formatFingerprint(Fingerprint(content)))
 
 	fullContent := frontmatter + string(content)
 
@@ -306,7 +307,8 @@ func (sm *StorageManager) DocumentExists(uri string) bool {
 	return err == nil
 }
 
-// Cleanup removes documents older than the specified TTL
+// Cleanup removes documents older than the specified TTL, cached analyses that are older
+// or refer to a removed document, and the screening rules of removed job descriptions
 func (sm *StorageManager) Cleanup(ttl time.Duration) (int64, error) {
 	ctx := context.Background()
 	if ttl == 0 {
@@ -346,8 +348,13 @@ func (sm *StorageManager) Cleanup(ttl time.Duration) (int64, error) {
 		}
 	}
 
+	cacheRemoved := sm.pruneAnalysisCache(ctx, cutoff)
+	rulesRemoved := sm.pruneRules(ctx)
+
 	sm.logger.InfoContext(ctx, "storage cleanup completed",
 		"removed", removed,
+		"cache_removed", cacheRemoved,
+		"rules_removed", rulesRemoved,
 		"ttl", ttl,
 	)
 
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// outcomesDirName is the storage subdirectory holding labeled hiring outcomes
+	outcomesDirName = "outcomes"
+
+	// profilesDirName is the storage subdirectory holding named scoring profiles
+	profilesDirName = "profiles"
+
+	// calibrationDirName is the storage subdirectory holding score distributions for percentiles
+	calibrationDirName = "calibration"
+)
+
+// profileNamePattern restricts profile names to safe file names
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// OutcomeID returns the ID of the outcome of a CV/JD pair. Recording an outcome for the same pair
+// again replaces the earlier one.
+func OutcomeID(cvURI, jdURI string) string {
+	return GenerateIDFromString(cvURI + "|" + jdURI)[:16]
+}
+
+// SaveOutcome stores a labeled outcome record. Outcomes are kept when their documents are
+// cleaned up, so training data outlives the document TTL.
+func (sm *StorageManager) SaveOutcome(id string, data []byte) error {
+	return sm.writeRecord(outcomesDirName, id, data, "save outcome")
+}
+
+// ReadOutcomes returns all stored outcome records, ordered by ID
+func (sm *StorageManager) ReadOutcomes() ([][]byte, error) {
+	dir := filepath.Join(sm.basePath, outcomesDirName)
+	entries, err := sm.fs.ReadDir(dir)
+	if err != nil {
+		if _, statErr := sm.fs.Stat(dir); statErr != nil {
+			// No outcome has been recorded yet
+			return nil, nil
+		}
+		return nil, &StorageError{Operation: "list outcomes", Path: dir, Err: err}
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".json") && !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	records := make([][]byte, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		data, err := sm.fs.ReadFile(path)
+		if err != nil {
+			return nil, &StorageError{Operation: "read outcome", Path: path, Err: err}
+		}
+		records = append(records, data)
+	}
+	return records, nil
+}
+
+// ValidateProfileName checks that a scoring profile name is lowercase letters, digits, '-' and '_'
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 64 lowercase letters, digits, '-' or '_'", name)
+	}
+	return nil
+}
+
+// SaveProfile stores a named scoring profile, replacing any profile of the same name
+func (sm *StorageManager) SaveProfile(name string, data []byte) error {
+	if err := ValidateProfileName(name); err != nil {
+		return &StorageError{Operation: "save profile", Err: err}
+	}
+	return sm.writeRecord(profilesDirName, name, data, "save profile")
+}
+
+// ReadProfile reads a named scoring profile
+func (sm *StorageManager) ReadProfile(name string) ([]byte, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return nil, &StorageError{Operation: "read profile", Err: err}
+	}
+	path := filepath.Join(sm.basePath, profilesDirName, name+".json")
+	data, err := sm.fs.ReadFile(path)
+	if err != nil {
+		return nil, &StorageError{Operation: "read profile", Path: path, Err: err}
+	}
+	return data, nil
+}
+
+// writeRecord writes a JSON record to a storage subdirectory
+func (sm *StorageManager) writeRecord(dirName, id string, data []byte, operation string) error {
+	dir := filepath.Join(sm.basePath, dirName)
+	if err := sm.fs.MkdirAll(dir, 0755); err != nil {
+		return &StorageError{Operation: "create " + dirName + " directory", Path: dir, Err: err}
+	}
+	path := filepath.Join(dir, id+".json")
+	if err := sm.fs.WriteFile(path, data, 0644); err != nil {
+		return &StorageError{Operation: operation, Path: path, Err: err}
+	}
+
+	sm.logger.DebugContext(context.Background(), "record saved", "dir", dirName, "id", id)
+	return nil
+}
+
+// CalibrationID returns the ID of the score distribution of a calibration group, e.g. a JD or a JD family
+func CalibrationID(scope, group string) string {
+	return scope + "-" + GenerateIDFromString(group)[:16]
+}
+
+// SaveCalibration stores the score distribution of a calibration group. Distributions are kept
+// when documents are cleaned up, so percentiles cover every applicant seen.
+func (sm *StorageManager) SaveCalibration(id string, data []byte) error {
+	return sm.writeRecord(calibrationDirName, id, data, "save calibration")
+}
+
+// ReadCalibration reads the score distribution of a calibration group, or returns nil when
+// no score has been recorded for it yet
+func (sm *StorageManager) ReadCalibration(id string) ([]byte, error) {
+	path := filepath.Join(sm.basePath, calibrationDirName, id+".json")
+	if _, err := sm.fs.Stat(path); err != nil {
+		return nil, nil
+	}
+	data, err := sm.fs.ReadFile(path)
+	if err != nil {
+		return nil, &StorageError{Operation: "read calibration", Path: path, Err: err}
+	}
+	return data, nil
+}
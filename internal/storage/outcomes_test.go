@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageManager_Outcomes(t *testing.T) {
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		DefaultTTL: time.Hour,
+		FileSystem: NewMemMapFileSystem(),
+	})
+	require.NoError(t, err)
+
+	records, err := sm.ReadOutcomes()
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	first := OutcomeID("cv://a", "jd://b")
+	assert.Equal(t, first, OutcomeID("cv://a", "jd://b"))
+	second := OutcomeID("cv://c", "jd://b")
+	assert.NotEqual(t, first, second)
+
+	require.NoError(t, sm.SaveOutcome(first, []byte(`{"label":"rejected"}`)))
+	require.NoError(t, sm.SaveOutcome(second, []byte(`{"label":"hired"}`)))
+	// Recording the same pair again replaces the outcome
+	require.NoError(t, sm.SaveOutcome(first, []byte(`{"label":"hired"}`)))
+
+	records, err = sm.ReadOutcomes()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		assert.JSONEq(t, `{"label":"hired"}`, string(record))
+	}
+
+	// Outcomes outlive their documents
+	_, err = sm.Cleanup(time.Nanosecond)
+	require.NoError(t, err)
+	records, err = sm.ReadOutcomes()
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+}
+
+func TestStorageManager_Profiles(t *testing.T) {
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		DefaultTTL: time.Hour,
+		FileSystem: NewMemMapFileSystem(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, sm.SaveProfile("backend-eu", []byte(`{"name":"backend-eu"}`)))
+	data, err := sm.ReadProfile("backend-eu")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"backend-eu"}`, string(data))
+
+	_, err = sm.ReadProfile("missing")
+	assert.Error(t, err)
+	for _, name := range []string{"", "../cv/x", "Backend", "a b"} {
+		assert.Error(t, sm.SaveProfile(name, []byte(`{}`)), name)
+		assert.Error(t, ValidateProfileName(name), name)
+	}
+}
+
+func TestStorageManager_Calibration(t *testing.T) {
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		DefaultTTL: time.Hour,
+		FileSystem: NewMemMapFileSystem(),
+	})
+	require.NoError(t, err)
+
+	id := CalibrationID("jd", "jd://b")
+	assert.Equal(t, id, CalibrationID("jd", "jd://b"))
+	assert.NotEqual(t, id, CalibrationID("family", "jd://b"))
+	assert.Regexp(t, `^jd-[0-9a-f]{16}$`, id)
+
+	data, err := sm.ReadCalibration(id)
+	require.NoError(t, err)
+	assert.Nil(t, data, "an unknown group has no distribution yet")
+
+	require.NoError(t, sm.SaveCalibration(id, []byte(`{"counts":[1]}`)))
+	data, err = sm.ReadCalibration(id)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"counts":[1]}`, string(data))
+}
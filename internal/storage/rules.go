@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// rulesDirName is the storage subdirectory holding screening rules per job description
+const rulesDirName = "rules"
+
+// rulesID returns the JD ID screening rules are stored under
+func rulesID(jdURI string) (string, error) {
+	docType, id, err := ParseURI(jdURI)
+	if err != nil {
+		return "", err
+	}
+	if docType != DocumentTypeJD {
+		return "", fmt.Errorf("screening rules belong to a job description, got %s", jdURI)
+	}
+	return id, nil
+}
+
+// SaveRules stores the screening rules of a job description, replacing earlier ones
+func (sm *StorageManager) SaveRules(jdURI string, data []byte) error {
+	id, err := rulesID(jdURI)
+	if err != nil {
+		return &StorageError{Operation: "save rules", Path: jdURI, Err: err}
+	}
+	return sm.writeRecord(rulesDirName, id, data, "save rules")
+}
+
+// ReadRules reads the screening rules of a job description, or returns nil when none are stored
+func (sm *StorageManager) ReadRules(jdURI string) ([]byte, error) {
+	id, err := rulesID(jdURI)
+	if err != nil {
+		return nil, &StorageError{Operation: "read rules", Path: jdURI, Err: err}
+	}
+	path := filepath.Join(sm.basePath, rulesDirName, id+".json")
+	if _, err := sm.fs.Stat(path); err != nil {
+		return nil, nil
+	}
+	data, err := sm.fs.ReadFile(path)
+	if err != nil {
+		return nil, &StorageError{Operation: "read rules", Path: path, Err: err}
+	}
+	return data, nil
+}
+
+// DeleteRules removes the screening rules of a job description; it is not an error when none are stored
+func (sm *StorageManager) DeleteRules(jdURI string) error {
+	id, err := rulesID(jdURI)
+	if err != nil {
+		return &StorageError{Operation: "delete rules", Path: jdURI, Err: err}
+	}
+	path := filepath.Join(sm.basePath, rulesDirName, id+".json")
+	if _, err := sm.fs.Stat(path); err != nil {
+		return nil
+	}
+	if err := sm.fs.Remove(path); err != nil {
+		return &StorageError{Operation: "delete rules", Path: path, Err: err}
+	}
+	return nil
+}
+
+// pruneRules removes the screening rules of job descriptions that are no longer stored
+func (sm *StorageManager) pruneRules(ctx context.Context) int64 {
+	dir := filepath.Join(sm.basePath, rulesDirName)
+	entries, err := sm.fs.ReadDir(dir)
+	if err != nil {
+		// No rules have been stored yet
+		return 0
+	}
+
+	_, jdIDs, err := sm.ListAllDocuments()
+	if err != nil {
+		return 0
+	}
+	stored := make(map[string]bool, len(jdIDs))
+	for _, id := range jdIDs {
+		stored[id] = true
+	}
+
+	var removed int64
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		if !stored[strings.TrimSuffix(entry.Name(), ".json")] {
+			if err := sm.fs.Remove(filepath.Join(dir, entry.Name())); err == nil {
+				removed++
+			}
+		}
+	}
+
+	sm.logger.DebugContext(ctx, "screening rules pruned", "removed", removed)
+	return removed
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageManager_Rules(t *testing.T) {
+	fs := NewMemMapFileSystem()
+	sm, err := NewStorageManager(StorageConfig{
+		BasePath:   "/test-storage",
+		DefaultTTL: time.Hour,
+		FileSystem: fs,
+	})
+	require.NoError(t, err)
+
+	jdURI, err := sm.SaveDocument(DocumentTypeJD, []byte("Test JD"), "jd.md")
+	require.NoError(t, err)
+	otherURI, err := sm.SaveDocument(DocumentTypeJD, []byte("Other JD"), "other.md")
+	require.NoError(t, err)
+
+	data, err := sm.ReadRules(jdURI)
+	require.NoError(t, err)
+	assert.Nil(t, data, "no rules stored yet")
+
+	require.NoError(t, sm.SaveRules(jdURI, []byte(`{"rules":"skill Go"}`)))
+	require.NoError(t, sm.SaveRules(otherURI, []byte(`{"rules":"years >= 3"}`)))
+	// Saving again replaces the rules
+	require.NoError(t, sm.SaveRules(jdURI, []byte(`{"rules":"skill Rust"}`)))
+
+	data, err = sm.ReadRules(jdURI)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"rules":"skill Rust"}`, string(data))
+
+	assert.Error(t, sm.SaveRules("cv://abc", []byte("{}")), "rules belong to a JD")
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, sm.DeleteRules(otherURI))
+		data, err := sm.ReadRules(otherURI)
+		require.NoError(t, err)
+		assert.Nil(t, data)
+		assert.NoError(t, sm.DeleteRules(otherURI), "deleting missing rules is not an error")
+	})
+
+	t.Run("cleanup drops rules of removed JDs", func(t *testing.T) {
+		require.NoError(t, sm.SaveRules(otherURI, []byte(`{"rules":"years >= 3"}`)))
+		path, err := sm.GetDocumentPath(jdURI)
+		require.NoError(t, err)
+		require.NoError(t, fs.Remove(path))
+
+		_, err = sm.Cleanup(time.Hour)
+		require.NoError(t, err)
+
+		data, err := sm.ReadRules(jdURI)
+		require.NoError(t, err)
+		assert.Nil(t, data)
+		data, err = sm.ReadRules(otherURI)
+		require.NoError(t, err)
+		assert.NotNil(t, data, "rules of stored JDs are kept")
+	})
+}